@@ -1,32 +1,176 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"time"
+	// Time zones of opening hours should not depend on the system database.
+	_ "time/tzdata"
 
-    "google.golang.org/grpc"
+	"google.golang.org/grpc"
 
 	"github.com/msik-404/micro-appoint-companies/internal/companiespb"
 	"github.com/msik-404/micro-appoint-companies/internal/database"
+	"github.com/msik-404/micro-appoint-companies/internal/events"
+	"github.com/msik-404/micro-appoint-companies/internal/migrations"
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+	"github.com/msik-404/micro-appoint-companies/internal/models/memory"
+	"github.com/msik-404/micro-appoint-companies/internal/pagetoken"
+	"github.com/msik-404/micro-appoint-companies/internal/purge"
 )
 
-func main() {
+const usage = `usage:
+  companies                     run the gRPC server
+  companies migrate up          apply all pending migrations
+  companies migrate down        revert the last applied migration
+  companies migrate status      list migrations and their state`
+
+// newServer wires repositories selected by STORAGE env variable. Setting
+// it to "memory" runs the service without MongoDB.
+func newServer() (*companiespb.Server, error) {
+	// Without MongoDB the service runs only for development, its data does
+	// not survive restart either.
+	secret, err := pagetoken.SecretFromEnv(os.Getenv("STORAGE") == "memory")
+	if err != nil {
+		return nil, err
+	}
+	pageTokens := pagetoken.NewSigner(secret)
+	if os.Getenv("STORAGE") == "memory" {
+		store := memory.NewStore()
+		return &companiespb.Server{
+			Companies:  store.Companies(),
+			Services:   store.Services(),
+			Closures:   store.Closures(),
+			Staff:      store.Staff(),
+			Categories: store.Categories(),
+			Audit:      store.Audit(),
+			Outbox:     store.Outbox(),
+			Tx:         store,
+			PageTokens: pageTokens,
+		}, nil
+	}
 	mongoClient, err := database.ConnectDB()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	_, err = database.CreateDBIndexes(mongoClient)
 	if err != nil {
-		panic(err)
-	}
-    port := 50051
-    lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port)) 
-    if err != nil {
-        panic(err)
-    }
-    s := grpc.NewServer()
-    companiespb.RegisterApiServer(s, &companiespb.Server{Client: mongoClient})
-    if err := s.Serve(lis); err != nil {
-        panic(err)
-    }
+		return nil, err
+	}
+	db := mongoClient.Database(database.DBName)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	tx := &models.MongoTransactor{Client: mongoClient}
+	err = tx.CheckTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = migrations.New(db).Up(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &companiespb.Server{
+		Companies:  &models.MongoCompanyRepository{DB: db},
+		Services:   &models.MongoServiceRepository{DB: db},
+		Closures:   &models.MongoClosureRepository{DB: db},
+		Staff:      &models.MongoStaffRepository{DB: db},
+		Categories: &models.MongoCategoryRepository{DB: db},
+		Audit:      &models.MongoAuditRepository{DB: db},
+		Outbox:     &models.MongoOutboxRepository{DB: db},
+		Tx:         tx,
+		PageTokens: pageTokens,
+	}, nil
+}
+
+func serve() error {
+	server, err := newServer()
+	if err != nil {
+		return err
+	}
+	retention, err := purge.RetentionFromEnv()
+	if err != nil {
+		return err
+	}
+	go purge.Run(context.Background(), server.Companies, retention, time.Hour)
+	// Without configured publisher events wait in the outbox.
+	if os.Getenv("EVENT_PUBLISHER") == "log" {
+		relay := &events.Relay{
+			Outbox:    server.Outbox,
+			Publisher: events.LogPublisher{},
+			BatchSize: 100,
+		}
+		go relay.Run(context.Background(), time.Second)
+	}
+	port := 50051
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer()
+	companiespb.RegisterApiServer(s, server)
+	return s.Serve(lis)
+}
+
+func migrate(command string) error {
+	mongoClient, err := database.ConnectDB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	defer mongoClient.Disconnect(ctx)
+	migrator := migrations.New(mongoClient.Database(database.DBName))
+
+	switch command {
+	case "up":
+		versions, err := migrator.Up(ctx)
+		for _, version := range versions {
+			fmt.Printf("applied %d\n", version)
+		}
+		if err == nil && len(versions) == 0 {
+			fmt.Println("nothing to apply")
+		}
+		return err
+	case "down":
+		version, err := migrator.Down(ctx)
+		if err == nil && version == 0 {
+			fmt.Println("nothing to revert")
+		} else if err == nil {
+			fmt.Printf("reverted %d\n", version)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%4d  %-30s  %s\n", status.Version, state, status.Description)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown migrate command %q\n%s", command, usage)
+}
+
+func main() {
+	var err error
+	switch {
+	case len(os.Args) == 1:
+		err = serve()
+	case len(os.Args) == 3 && os.Args[1] == "migrate":
+		err = migrate(os.Args[2])
+	default:
+		err = errors.New(usage)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,196 @@
+package companiespb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// ActorMetadataKey is the gRPC metadata key under which callers pass
+// identity of the user making the change.
+const ActorMetadataKey = "x-actor"
+
+const anonymousActor = "anonymous"
+
+func actorFromContext(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, ActorMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return anonymousActor
+	}
+	return values[0]
+}
+
+// mutation made by one of RPCs. It is recorded in the audit log and
+// written to the outbox as domain events of given types.
+type mutation struct {
+	rpc string
+	// serviceID is zero for mutations of companies.
+	companyID primitive.ObjectID
+	serviceID primitive.ObjectID
+	changes   []models.FieldChange
+	events    []string
+}
+
+// eventPayload is JSON body of domain events written to the outbox.
+type eventPayload struct {
+	Changes []models.FieldChange `json:"changes,omitempty"`
+}
+
+// record should be called in the same transaction as the mutation.
+func (s *Server) record(ctx context.Context, m mutation) error {
+	now := time.Now().UTC()
+	auditEvent := models.AuditEvent{
+		Actor:     actorFromContext(ctx),
+		RPC:       m.rpc,
+		CompanyID: m.companyID,
+		ServiceID: m.serviceID,
+		Changes:   m.changes,
+		CreatedAt: now,
+	}
+	if err := s.Audit.InsertOne(ctx, &auditEvent); err != nil {
+		return err
+	}
+	payload, err := json.Marshal(eventPayload{Changes: m.changes})
+	if err != nil {
+		return err
+	}
+	for _, eventType := range m.events {
+		outboxEvent := models.OutboxEvent{
+			Type:       eventType,
+			CompanyID:  m.companyID,
+			ServiceID:  m.serviceID,
+			Payload:    string(payload),
+			OccurredAt: now,
+		}
+		if err := s.Outbox.InsertOne(ctx, &outboxEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordCascade records updates of services which lost reference to
+// a deleted staff member or category. services should be read before the
+// deletion, clear removes the reference from a copy of each of them.
+func (s *Server) recordCascade(
+	ctx context.Context,
+	rpc string,
+	companyID primitive.ObjectID,
+	services []models.Service,
+	clear func(service *models.Service),
+) error {
+	for idx := range services {
+		before := &services[idx]
+		after := *before
+		clear(&after)
+		err := s.record(ctx, mutation{
+			rpc:       rpc,
+			companyID: companyID,
+			serviceID: before.ID,
+			changes:   models.ServiceDiff(before, &after),
+			events:    []string{models.ServiceUpdated},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) ListAuditEvents(
+	ctx context.Context,
+	request *AuditEventsRequest,
+) (*AuditEventsReply, error) {
+	var filter models.AuditFilter
+	var err error
+	if request.CompanyId != nil {
+		filter.CompanyID, err = primitive.ObjectIDFromHex(request.GetCompanyId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if request.From != nil {
+		if err := request.From.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		from := request.From.AsTime()
+		filter.From = &from
+	}
+	if request.To != nil {
+		if err := request.To.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		to := request.To.AsTime()
+		filter.To = &to
+	}
+	_, startValueHex, err := s.readPageToken(
+		request,
+		request.PageToken,
+		nil,
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	startValue := primitive.NilObjectID
+	if startValueHex != nil {
+		startValue, err = primitive.ObjectIDFromHex(*startValueHex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	nPerPage, err := pageSize(request.NPerPage)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.Audit.FindMany(ctx, filter, startValue, pageLimit(nPerPage))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	events, hasMore := trimPage(events, nPerPage)
+	reply := &AuditEventsReply{HasMore: &hasMore}
+	for idx := range events {
+		eventModel := &events[idx]
+		eventID := eventModel.ID.Hex()
+		companyID := eventModel.CompanyID.Hex()
+		eventProto := &AuditEvent{
+			Id:        &eventID,
+			Actor:     &eventModel.Actor,
+			Rpc:       &eventModel.RPC,
+			CompanyId: &companyID,
+			CreatedAt: timestamppb.New(eventModel.CreatedAt),
+		}
+		if !eventModel.ServiceID.IsZero() {
+			serviceID := eventModel.ServiceID.Hex()
+			eventProto.ServiceId = &serviceID
+		}
+		for changeIdx := range eventModel.Changes {
+			change := &eventModel.Changes[changeIdx]
+			eventProto.Changes = append(eventProto.Changes, &FieldChange{
+				Field:  &change.Field,
+				Before: &change.Before,
+				After:  &change.After,
+			})
+		}
+		reply.Events = append(reply.Events, eventProto)
+	}
+	if hasMore {
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			"",
+			events[len(events)-1].ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
@@ -0,0 +1,334 @@
+package companiespb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// categoriesLimit is the maximum number of categories of a single company.
+const categoriesLimit = 50
+
+var errTooManyCategories = fmt.Errorf(
+	"Company can have at most %d categories",
+	categoriesLimit,
+)
+
+// errUnknownCategory is returned when category of the service does not
+// belong to the company.
+var errUnknownCategory = errors.New("Category does not belong to the company")
+
+// parseCategoryID parses optional category id, empty string is parsed to
+// zero id which stands for no category.
+func parseCategoryID(hex *string) (*primitive.ObjectID, error) {
+	if hex == nil {
+		return nil, nil
+	}
+	categoryID := primitive.NilObjectID
+	if *hex != "" {
+		var err error
+		categoryID, err = primitive.ObjectIDFromHex(*hex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return &categoryID, nil
+}
+
+// checkCategory should be called in the transaction which assigns the
+// category to a service.
+func (s *Server) checkCategory(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID *primitive.ObjectID,
+) error {
+	if categoryID == nil || categoryID.IsZero() {
+		return nil
+	}
+	_, err := s.Categories.FindOne(ctx, companyID, *categoryID)
+	if errors.Is(err, models.ErrNotFound) {
+		return fmt.Errorf("%w: %s", errUnknownCategory, categoryID.Hex())
+	}
+	return err
+}
+
+// checkCategoryName returns models.ErrDuplicate when other category of the
+// company has the same name.
+func (s *Server) checkCategoryName(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	name string,
+) ([]models.Category, error) {
+	categories, err := s.Categories.FindMany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories {
+		if category.ID != categoryID && category.Name == name {
+			return nil, models.ErrDuplicate
+		}
+	}
+	return categories, nil
+}
+
+func newCategoryProto(categoryModel *models.Category) *Category {
+	categoryID := categoryModel.ID.Hex()
+	return &Category{
+		Id:       &categoryID,
+		Name:     &categoryModel.Name,
+		Position: &categoryModel.Position,
+		Version:  &categoryModel.Version,
+	}
+}
+
+func (s *Server) AddCategory(
+	ctx context.Context,
+	request *AddCategoryRequest,
+) (*AddCategoryReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name should be set")
+	}
+	err = verifyString(request.Name, 30)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyInteger(request.Position, -1, 10000)
+	if err != nil {
+		return nil, err
+	}
+	category := models.Category{
+		Name:     request.GetName(),
+		Position: request.GetPosition(),
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		categories, err := s.checkCategoryName(
+			ctx,
+			companyID,
+			primitive.NilObjectID,
+			category.Name,
+		)
+		if err != nil {
+			return err
+		}
+		if len(categories) >= categoriesLimit {
+			return errTooManyCategories
+		}
+		category.ID, err = s.Categories.InsertOne(ctx, companyID, &category)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "AddCategory",
+			companyID: companyID,
+			changes:   models.CategoryDiff(nil, &category),
+			events:    []string{models.CategoryCreated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, errTooManyCategories) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, models.ErrDuplicate) {
+			return nil, status.Error(
+				codes.AlreadyExists,
+				"Category with that name already exists",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &AddCategoryReply{Id: proto.String(category.ID.Hex())}, nil
+}
+
+func (s *Server) UpdateCategory(
+	ctx context.Context,
+	request *UpdateCategoryRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	categoryID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.Name != nil && request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name should not be empty")
+	}
+	err = verifyString(request.Name, 30)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyInteger(request.Position, -1, 10000)
+	if err != nil {
+		return nil, err
+	}
+	categoryUpdate := models.CategoryUpdate{
+		Name:     request.Name,
+		Position: request.Position,
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Categories.FindOne(ctx, companyID, categoryID)
+		if err != nil {
+			return err
+		}
+		if request.Name != nil {
+			_, err := s.checkCategoryName(ctx, companyID, categoryID, request.GetName())
+			if err != nil {
+				return err
+			}
+		}
+		err = s.Categories.UpdateOne(
+			ctx,
+			companyID,
+			categoryID,
+			&categoryUpdate,
+			request.Version,
+		)
+		if err != nil {
+			return err
+		}
+		after := *before
+		categoryUpdate.Apply(&after)
+		return s.record(ctx, mutation{
+			rpc:       "UpdateCategory",
+			companyID: companyID,
+			changes:   models.CategoryDiff(before, &after),
+			events:    []string{models.CategoryUpdated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Category was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrDuplicate) {
+			return nil, status.Error(
+				codes.AlreadyExists,
+				"Category with that name already exists",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Category with that companyID and categoryID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DeleteCategory(
+	ctx context.Context,
+	request *DeleteCategoryRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	categoryID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Categories.FindOne(ctx, companyID, categoryID)
+		if err != nil {
+			return err
+		}
+		services, err := s.Services.FindMany(
+			ctx,
+			companyID,
+			models.ServiceFilter{CategoryID: &categoryID},
+			models.Sort{},
+			models.Cursor{},
+			0,
+		)
+		if err != nil {
+			return err
+		}
+		err = s.Categories.DeleteOne(ctx, companyID, categoryID, request.Version)
+		if err != nil {
+			return err
+		}
+		err = s.record(ctx, mutation{
+			rpc:       "DeleteCategory",
+			companyID: companyID,
+			changes:   models.CategoryDiff(before, nil),
+			events:    []string{models.CategoryDeleted},
+		})
+		if err != nil {
+			return err
+		}
+		return s.recordCascade(
+			ctx,
+			"DeleteCategory",
+			companyID,
+			services,
+			func(service *models.Service) {
+				service.CategoryID = primitive.NilObjectID
+			},
+		)
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Category was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Category with that companyID and categoryID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) FindManyCategories(
+	ctx context.Context,
+	request *CategoriesRequest,
+) (*CategoriesReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	categories, err := s.Categories.FindMany(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := &CategoriesReply{}
+	for idx := range categories {
+		reply.Categories = append(reply.Categories, newCategoryProto(&categories[idx]))
+	}
+	return reply, nil
+}
@@ -0,0 +1,232 @@
+package companiespb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// closuresLimit is the maximum number of closures of a single company.
+const closuresLimit = 100
+
+// closureDaysLimit is the maximum number of days of a single closure.
+const closureDaysLimit = 366
+
+var errTooManyClosures = fmt.Errorf(
+	"Company can have at most %d closures",
+	closuresLimit,
+)
+
+func newClosureProto(closureModel *models.Closure) *Closure {
+	closureID := closureModel.ID.Hex()
+	return &Closure{
+		Id:        &closureID,
+		StartDate: &closureModel.StartDate,
+		EndDate:   &closureModel.EndDate,
+		Reason:    &closureModel.Reason,
+	}
+}
+
+func (s *Server) AddClosure(
+	ctx context.Context,
+	request *AddClosureRequest,
+) (*AddClosureReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = verifyDateRange(request.StartDate, request.EndDate, closureDaysLimit)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyString(request.Reason, 150)
+	if err != nil {
+		return nil, err
+	}
+	closure := models.Closure{
+		StartDate: request.GetStartDate(),
+		EndDate:   request.GetEndDate(),
+		Reason:    request.GetReason(),
+	}
+	var closureID primitive.ObjectID
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		closures, err := s.Closures.FindMany(ctx, companyID, "", "")
+		if err != nil {
+			return err
+		}
+		if len(closures) >= closuresLimit {
+			return errTooManyClosures
+		}
+		closureID, err = s.Closures.InsertOne(ctx, companyID, &closure)
+		if err != nil {
+			return err
+		}
+		closure.ID = closureID
+		return s.record(ctx, mutation{
+			rpc:       "AddClosure",
+			companyID: companyID,
+			changes:   models.ClosureDiff(nil, &closure),
+			events:    []string{models.ClosureAdded},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, errTooManyClosures) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &AddClosureReply{Id: proto.String(closureID.Hex())}, nil
+}
+
+func (s *Server) DeleteClosure(
+	ctx context.Context,
+	request *DeleteClosureRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	closureID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Closures.FindOne(ctx, companyID, closureID)
+		if err != nil {
+			return err
+		}
+		err = s.Closures.DeleteOne(ctx, companyID, closureID)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "DeleteClosure",
+			companyID: companyID,
+			changes:   models.ClosureDiff(before, nil),
+			events:    []string{models.ClosureRemoved},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company or closure with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListClosures(
+	ctx context.Context,
+	request *ClosuresRequest,
+) (*ClosuresReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, date := range []*string{request.StartDate, request.EndDate} {
+		if date != nil {
+			if err := verifyDate(*date); err != nil {
+				return nil, err
+			}
+		}
+	}
+	closures, err := s.Closures.FindMany(
+		ctx,
+		companyID,
+		request.GetStartDate(),
+		request.GetEndDate(),
+	)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := &ClosuresReply{}
+	for idx := range closures {
+		reply.Closures = append(reply.Closures, newClosureProto(&closures[idx]))
+	}
+	return reply, nil
+}
+
+// IsOpenAt checks closures first and then opening hours of the day of the
+// requested time in the company time zone.
+func (s *Server) IsOpenAt(
+	ctx context.Context,
+	request *IsOpenAtRequest,
+) (*IsOpenAtReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.Time == nil {
+		return nil, status.Error(codes.InvalidArgument, "time should be set")
+	}
+	if err := request.Time.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	companyModel, err := s.Companies.FindOne(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	hours := companyModel.OpeningHours
+	if hours == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			"Company has no opening hours set",
+		)
+	}
+	location, err := hours.Location()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	at := request.Time.AsTime().In(location)
+	date := at.Format(models.DateLayout)
+	closures, err := s.Closures.FindMany(ctx, companyID, date, date)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(closures) != 0 {
+		return &IsOpenAtReply{
+			Open:    proto.Bool(false),
+			Closure: newClosureProto(&closures[0]),
+		}, nil
+	}
+	open, err := hours.IsOpenAt(at)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &IsOpenAtReply{Open: &open}, nil
+}
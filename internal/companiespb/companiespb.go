@@ -2,20 +2,30 @@ package companiespb
 
 import (
 	"context"
+	"errors"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/msik-404/micro-appoint-companies/internal/database"
 	"github.com/msik-404/micro-appoint-companies/internal/models"
+	"github.com/msik-404/micro-appoint-companies/internal/pagetoken"
 )
 
 type Server struct {
 	UnimplementedApiServer
-	Client *mongo.Client
+	Companies  models.CompanyRepository
+	Services   models.ServiceRepository
+	Closures   models.ClosureRepository
+	Staff      models.StaffRepository
+	Categories models.CategoryRepository
+	Audit      models.AuditRepository
+	Outbox     models.OutboxRepository
+	Tx         models.Transactor
+	// PageTokens signs page tokens of list replies.
+	PageTokens *pagetoken.Signer
 }
 
 func (s *Server) AddService(
@@ -26,39 +36,78 @@ func (s *Server) AddService(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	err = verifyString(request.Name, 30)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyInteger(request.Price, 0, 1000000)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyInteger(request.Duration, 0, 480)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyString(request.Description, 300)
+	err = verifyAddService(request)
 	if err != nil {
 		return nil, err
 	}
 	// check for nil
 	newSerivce := models.Service{
 		Name:        request.GetName(),
-		Price:       request.GetPrice(),
 		Duration:    request.GetDuration(),
 		Description: request.GetDescription(),
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := newSerivce.InsertOne(ctx, db, companyID)
+	categoryID, err := parseCategoryID(request.CategoryId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	if result.MatchedCount == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"Company with that id was not found",
-		)
+	if categoryID != nil {
+		newSerivce.CategoryID = *categoryID
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		company, err := s.Companies.FindOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		newSerivce.Price, err = newMoney(request.Price, company.Currency)
+		if err != nil {
+			return err
+		}
+		currency := newSerivce.Price.Currency
+		newSerivce.Variants, err = newVariants(request.Variants, currency)
+		if err != nil {
+			return err
+		}
+		newSerivce.AddOns, err = newAddOns(request.AddOns, currency)
+		if err != nil {
+			return err
+		}
+		err = checkCurrencies(&newSerivce)
+		if err != nil {
+			return err
+		}
+		err = s.checkCategory(ctx, companyID, categoryID)
+		if err != nil {
+			return err
+		}
+		err = s.Services.InsertOne(ctx, companyID, &newSerivce)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "AddService",
+			companyID: companyID,
+			serviceID: newSerivce.ID,
+			changes:   models.ServiceDiff(nil, &newSerivce),
+			events:    []string{models.ServiceCreated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, errUnknownCategory) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, errNoCurrency) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, errMixedCurrencies) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -79,7 +128,7 @@ func (s *Server) UpdateService(
 	if err != nil {
 		return nil, err
 	}
-	err = verifyInteger(request.Price, 0, 1000000)
+	err = verifyMoney(request.Price)
 	if err != nil {
 		return nil, err
 	}
@@ -91,22 +140,95 @@ func (s *Server) UpdateService(
 	if err != nil {
 		return nil, err
 	}
+	err = verifyVariants(
+		request.GetVariants().GetVariants(),
+		request.GetAddOns().GetAddOns(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	serviceUpdate := models.ServiceUpdate{
 		Name:        request.Name,
-		Price:       request.Price,
 		Duration:    request.Duration,
 		Description: request.Description,
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := serviceUpdate.UpdateOne(ctx, db, companyID, serviceID)
+	serviceUpdate.CategoryID, err = parseCategoryID(request.CategoryId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	if result.MatchedCount == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"Service with that companyID and serviceID was not found",
-		)
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Services.FindOne(ctx, companyID, serviceID)
+		if err != nil {
+			return err
+		}
+		err = s.checkCategory(ctx, companyID, serviceUpdate.CategoryID)
+		if err != nil {
+			return err
+		}
+		if request.Price != nil {
+			price, err := newMoney(request.Price, before.Price.Currency)
+			if err != nil {
+				return err
+			}
+			serviceUpdate.Price = &price
+		}
+		currency := before.Price.Currency
+		if serviceUpdate.Price != nil {
+			currency = serviceUpdate.Price.Currency
+		}
+		if request.Variants != nil {
+			variants, err := newVariants(request.Variants.GetVariants(), currency)
+			if err != nil {
+				return err
+			}
+			serviceUpdate.Variants = &variants
+		}
+		if request.AddOns != nil {
+			addOns, err := newAddOns(request.AddOns.GetAddOns(), currency)
+			if err != nil {
+				return err
+			}
+			serviceUpdate.AddOns = &addOns
+		}
+		after := *before
+		serviceUpdate.Apply(&after)
+		err = checkCurrencies(&after)
+		if err != nil {
+			return err
+		}
+		err = s.Services.UpdateOne(ctx, companyID, serviceID, &serviceUpdate, request.Version)
+		if err != nil {
+			return err
+		}
+		events := []string{models.ServiceUpdated}
+		if before.Price != after.Price {
+			events = append(events, models.ServicePriceChanged)
+		}
+		return s.record(ctx, mutation{
+			rpc:       "UpdateService",
+			companyID: companyID,
+			serviceID: serviceID,
+			changes:   models.ServiceDiff(before, &after),
+			events:    events,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Service was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, errUnknownCategory) || errors.Is(err, errMixedCurrencies) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Service with that companyID and serviceID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -123,17 +245,38 @@ func (s *Server) DeleteService(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := models.DeleteOneService(ctx, db, companyID, serviceID)
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Services.FindOne(ctx, companyID, serviceID)
+		if err != nil {
+			return err
+		}
+		err = s.Services.DeleteOne(ctx, companyID, serviceID, request.Version)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "DeleteService",
+			companyID: companyID,
+			serviceID: serviceID,
+			changes:   models.ServiceDiff(before, nil),
+			events:    []string{models.ServiceDeleted},
+		})
+	})
 	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Service was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Service with that companyID and serviceID was not found",
+			)
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if result.MatchedCount == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"Service with that companyID and serviceID was not found",
-		)
-	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -145,75 +288,107 @@ func (s *Server) FindManyServices(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	startValue := primitive.NilObjectID
-	if request.StartValue != nil {
-		startValue, err = primitive.ObjectIDFromHex(request.GetStartValue())
+	servicesSort, err := newServiceSort(request.GetSortBy(), request.GetDirection())
+	if err != nil {
+		return nil, err
+	}
+	startKey, startValue, err := s.readPageToken(
+		request,
+		request.PageToken,
+		request.StartKey,
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := newCursor(servicesSort, startKey, startValue)
+	if err != nil {
+		return nil, err
+	}
+	var filter models.ServiceFilter
+	if request.StaffId != nil {
+		filter.StaffID, err = primitive.ObjectIDFromHex(request.GetStaffId())
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 	}
-	var nPerPage int64 = 30
-	if request.NPerPage != nil {
-		nPerPage = request.GetNPerPage()
+	filter.CategoryID, err = parseCategoryID(request.CategoryId)
+	if err != nil {
+		return nil, err
 	}
-	db := s.Client.Database(database.DBName)
-	cursor, err := models.FindManyServices(ctx, db, companyID, startValue, nPerPage)
+	nPerPage, err := pageSize(request.NPerPage)
 	if err != nil {
+		return nil, err
+	}
+	services, err := s.Services.FindMany(
+		ctx,
+		companyID,
+		filter,
+		servicesSort,
+		cursor,
+		pageLimit(nPerPage),
+	)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	defer cursor.Close(ctx)
-	reply := &ServicesReply{}
-	for cursor.Next(ctx) {
-		var serviceModel models.Service
-		if err := cursor.Decode(&serviceModel); err != nil {
+	services, hasMore := trimPage(services, nPerPage)
+	reply := &ServicesReply{HasMore: &hasMore}
+	for idx := range services {
+		reply.Services = append(reply.Services, newServiceProto(&services[idx]))
+	}
+	if request.GetWithTotalCount() {
+		totalCount, err := s.Services.Count(ctx, companyID, filter)
+		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		serviceID := serviceModel.ID.Hex()
-		serviceProto := &Service{
-			Id:          &serviceID,
-			Name:        &serviceModel.Name,
-			Price:       &serviceModel.Price,
-			Duration:    &serviceModel.Duration,
-			Description: &serviceModel.Description,
-		}
-		reply.Services = append(reply.Services, serviceProto)
+		reply.TotalCount = &totalCount
 	}
-	if len(reply.Services) == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"This company does not have any services",
+	if hasMore {
+		last := &services[len(services)-1]
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			formatKey(servicesSort.ServiceKey(last)),
+			last.ID,
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return reply, nil
 }
 
+func newServiceProto(serviceModel *models.Service) *Service {
+	serviceID := serviceModel.ID.Hex()
+	serviceProto := &Service{
+		Id:          &serviceID,
+		Name:        &serviceModel.Name,
+		Price:       newMoneyProto(serviceModel.Price),
+		Duration:    &serviceModel.Duration,
+		Description: &serviceModel.Description,
+		Version:     &serviceModel.Version,
+	}
+	for _, staffID := range serviceModel.StaffIDs {
+		serviceProto.StaffIds = append(serviceProto.StaffIds, staffID.Hex())
+	}
+	if !serviceModel.CategoryID.IsZero() {
+		serviceProto.CategoryId = proto.String(serviceModel.CategoryID.Hex())
+	}
+	serviceProto.Variants = newVariantProtos(serviceModel.Variants)
+	serviceProto.AddOns = newAddOnProtos(serviceModel.AddOns)
+	return serviceProto
+}
+
 func (s *Server) AddCompany(
 	ctx context.Context,
 	request *AddCompanyRequest,
 ) (*AddCompanyReply, error) {
-	if request.Name == nil {
-		return nil, status.Error(
-			codes.InvalidArgument,
-			"name should be set",
-		)
-	}
-	err := verifyString(request.Name, 30)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyString(request.Type, 30)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyString(request.Localisation, 60)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyString(request.ShortDescription, 150)
-	if err != nil {
-		return nil, err
-	}
-	err = verifyString(request.LongDescription, 300)
+	err := verifyAddCompany(request)
 	if err != nil {
 		return nil, err
 	}
@@ -223,16 +398,30 @@ func (s *Server) AddCompany(
 		Localisation:     request.GetLocalisation(),
 		ShortDescription: request.GetShortDescription(),
 		LongDescription:  request.GetLongDescription(),
+		Location:         newGeoPoint(request.Location),
+		Currency:         request.GetCurrency(),
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := newCompany.InsertOne(ctx, db)
+	var companyID primitive.ObjectID
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		companyID, err = s.Companies.InsertOne(ctx, &newCompany)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "AddCompany",
+			companyID: companyID,
+			changes:   models.CompanyDiff(nil, &newCompany),
+			events:    []string{models.CompanyCreated},
+		})
+	})
 	if err != nil {
-		if mongo.IsDuplicateKeyError(err) {
+		if errors.Is(err, models.ErrDuplicate) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	insertedID := result.InsertedID.(primitive.ObjectID).Hex()
+	insertedID := companyID.Hex()
 	return &AddCompanyReply{
 		Id: &insertedID,
 	}, nil
@@ -266,27 +455,59 @@ func (s *Server) UpdateCompany(
 	if err != nil {
 		return nil, err
 	}
+	err = verifyGeoPoint(request.Location)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyCurrency(request.Currency)
+	if err != nil {
+		return nil, err
+	}
 	companyUpdate := models.CompanyUpdate{
 		Name:             request.Name,
 		Type:             request.Type,
 		Localisation:     request.Localisation,
 		ShortDescription: request.ShortDescription,
 		LongDescription:  request.LongDescription,
+		Location:         newGeoPoint(request.Location),
+		Currency:         request.Currency,
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := companyUpdate.UpdateOne(ctx, db, companyID)
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Companies.FindOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		err = s.Companies.UpdateOne(ctx, companyID, &companyUpdate, request.Version)
+		if err != nil {
+			return err
+		}
+		after := *before
+		companyUpdate.Apply(&after)
+		return s.record(ctx, mutation{
+			rpc:       "UpdateCompany",
+			companyID: companyID,
+			changes:   models.CompanyDiff(before, &after),
+			events:    []string{models.CompanyUpdated},
+		})
+	})
 	if err != nil {
-		if mongo.IsDuplicateKeyError(err) {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Company was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrDuplicate) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if result.MatchedCount == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"Company with that id was not found",
-		)
-	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -298,16 +519,61 @@ func (s *Server) DeleteCompany(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	db := s.Client.Database(database.DBName)
-	result, err := models.DeleteOneCompany(ctx, db, companyID)
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Companies.FindOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		err = s.Companies.DeleteOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "DeleteCompany",
+			companyID: companyID,
+			changes:   models.CompanyDiff(before, nil),
+			events:    []string{models.CompanyDeleted},
+		})
+	})
 	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if result.DeletedCount == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"Company with that id was not found",
-		)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RestoreCompany(
+	ctx context.Context,
+	request *RestoreCompanyRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.Companies.RestoreOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "RestoreCompany",
+			companyID: companyID,
+			events:    []string{models.CompanyRestored},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Deleted company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -320,78 +586,321 @@ func (s *Server) FindOneCompany(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	db := s.Client.Database(database.DBName)
-	var companyModel models.Company
-	err = models.FindOneCompany(ctx, db, companyID).Decode(&companyModel)
+	servicesSort, err := newServiceSort(
+		request.GetServicesSortBy(),
+		request.GetServicesDirection(),
+	)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		return nil, err
+	}
+	err = verifyInteger(request.ServicesNPerPage, 0, 100)
+	if err != nil {
+		return nil, err
+	}
+	var servicesNPerPage int64 = 10
+	if request.ServicesNPerPage != nil {
+		servicesNPerPage = *request.ServicesNPerPage
+	}
+	companyModel, err := s.Companies.FindOne(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// Token is issued for FindManyServices request which continues the page.
+	servicesRequest := &ServicesRequest{
+		CompanyId: request.Id,
+		SortBy:    request.GetServicesSortBy(),
+		Direction: request.GetServicesDirection(),
+	}
+	servicesGroup, err := s.firstServicesPage(
+		ctx,
+		companyID,
+		models.ServiceFilter{},
+		servicesRequest,
+		servicesSort,
+		servicesNPerPage,
+	)
+	if err != nil {
+		return nil, err
+	}
 	companyProto := &CompanyReply{
-		Name:             &companyModel.Name,
-		Type:             &companyModel.Type,
-		Localisation:     &companyModel.Localisation,
-		ShortDescription: &companyModel.ShortDescription,
-		LongDescription:  &companyModel.LongDescription,
+		Name:                  &companyModel.Name,
+		Type:                  &companyModel.Type,
+		Localisation:          &companyModel.Localisation,
+		ShortDescription:      &companyModel.ShortDescription,
+		LongDescription:       &companyModel.LongDescription,
+		Version:               &companyModel.Version,
+		Location:              newGeoPointProto(companyModel.Location),
+		Services:              servicesGroup.Services,
+		ServicesTotalCount:    servicesGroup.TotalCount,
+		ServicesNextPageToken: servicesGroup.NextPageToken,
+		OpeningHours:          newOpeningHoursProto(companyModel.OpeningHours),
+		Currency:              &companyModel.Currency,
 	}
-	for idx := range companyModel.Services {
-		serviceID := companyModel.Services[idx].ID.Hex()
-		serviceProto := Service{
-			Id:          &serviceID,
-			Name:        &companyModel.Services[idx].Name,
-			Price:       &companyModel.Services[idx].Price,
-			Duration:    &companyModel.Services[idx].Duration,
-			Description: &companyModel.Services[idx].Description,
+	if request.GetWithServiceGroups() {
+		companyProto.ServiceGroups, err = s.serviceGroups(
+			ctx,
+			companyID,
+			servicesRequest,
+			servicesSort,
+			servicesNPerPage,
+		)
+		if err != nil {
+			return nil, err
 		}
-		companyProto.Services = append(companyProto.Services, &serviceProto)
 	}
 	return companyProto, nil
 }
 
+// firstServicesPage returns the first page of services matching the filter
+// together with their total count. servicesRequest is the FindManyServices
+// request which continues the page.
+func (s *Server) firstServicesPage(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	filter models.ServiceFilter,
+	servicesRequest *ServicesRequest,
+	servicesSort models.Sort,
+	nPerPage int64,
+) (*ServiceGroup, error) {
+	services, err := s.Services.FindMany(
+		ctx,
+		companyID,
+		filter,
+		servicesSort,
+		models.Cursor{},
+		pageLimit(nPerPage),
+	)
+	var totalCount int64
+	if err == nil {
+		totalCount, err = s.Services.Count(ctx, companyID, filter)
+	}
+	if err != nil {
+		// company was deleted in the meantime
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.newServiceGroup(services, totalCount, servicesRequest, servicesSort, nPerPage)
+}
+
+// newServiceGroup converts a page of services fetched with
+// pageLimit(nPerPage).
+func (s *Server) newServiceGroup(
+	services []models.Service,
+	totalCount int64,
+	servicesRequest *ServicesRequest,
+	servicesSort models.Sort,
+	nPerPage int64,
+) (*ServiceGroup, error) {
+	services, hasMore := trimPage(services, nPerPage)
+	group := &ServiceGroup{TotalCount: &totalCount}
+	for idx := range services {
+		group.Services = append(group.Services, newServiceProto(&services[idx]))
+	}
+	if hasMore {
+		last := &services[len(services)-1]
+		var err error
+		group.NextPageToken, err = s.nextPageToken(
+			servicesRequest,
+			formatKey(servicesSort.ServiceKey(last)),
+			last.ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return group, nil
+}
+
+// serviceGroups returns first page of services of each category in
+// position order, followed by services without category.
+func (s *Server) serviceGroups(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	servicesRequest *ServicesRequest,
+	servicesSort models.Sort,
+	nPerPage int64,
+) ([]*ServiceGroup, error) {
+	categories, err := s.Categories.FindMany(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Pages of all groups are fetched at once.
+	serviceGroups, err := s.Services.FindGroups(
+		ctx,
+		companyID,
+		servicesSort,
+		pageLimit(nPerPage),
+	)
+	if err != nil {
+		// company was deleted in the meantime
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	byCategory := make(map[primitive.ObjectID]*models.ServiceGroup, len(serviceGroups))
+	for idx := range serviceGroups {
+		byCategory[serviceGroups[idx].CategoryID] = &serviceGroups[idx]
+	}
+	newGroup := func(categoryID primitive.ObjectID, groupRequest *ServicesRequest) (*ServiceGroup, error) {
+		serviceGroup, ok := byCategory[categoryID]
+		if !ok {
+			serviceGroup = &models.ServiceGroup{}
+		}
+		return s.newServiceGroup(
+			serviceGroup.Services,
+			serviceGroup.TotalCount,
+			groupRequest,
+			servicesSort,
+			nPerPage,
+		)
+	}
+	var groups []*ServiceGroup
+	for idx := range categories {
+		category := newCategoryProto(&categories[idx])
+		groupRequest := proto.Clone(servicesRequest).(*ServicesRequest)
+		groupRequest.CategoryId = category.Id
+		group, err := newGroup(categories[idx].ID, groupRequest)
+		if err != nil {
+			return nil, err
+		}
+		group.Category = category
+		groups = append(groups, group)
+	}
+	groupRequest := proto.Clone(servicesRequest).(*ServicesRequest)
+	groupRequest.CategoryId = proto.String("")
+	uncategorized, err := newGroup(primitive.NilObjectID, groupRequest)
+	if err != nil {
+		return nil, err
+	}
+	groups = append(groups, uncategorized)
+	return groups, nil
+}
+
+// newCompanyFilter validates filters of the request.
+func newCompanyFilter(request *CompaniesRequest) (models.CompanyFilter, error) {
+	filter := models.CompanyFilter{
+		Types:       request.GetTypes(),
+		NamePrefix:  request.GetNamePrefix(),
+		Currency:    request.GetPriceCurrency(),
+		MinPrice:    request.MinPrice,
+		MaxPrice:    request.MaxPrice,
+		MaxDuration: request.MaxDuration,
+	}
+	if len(filter.Types) > 10 {
+		return filter, status.Error(
+			codes.InvalidArgument,
+			"There should be at most 10 types",
+		)
+	}
+	for idx := range filter.Types {
+		err := verifyString(&filter.Types[idx], 30)
+		if err != nil {
+			return filter, err
+		}
+	}
+	err := verifyString(request.NamePrefix, 30)
+	if err != nil {
+		return filter, err
+	}
+	err = verifyCurrency(request.PriceCurrency)
+	if err != nil {
+		return filter, err
+	}
+	err = verifyInteger(request.MinPrice, -1, maxPrice)
+	if err != nil {
+		return filter, err
+	}
+	err = verifyInteger(request.MaxPrice, -1, maxPrice)
+	if err != nil {
+		return filter, err
+	}
+	if (filter.MinPrice != nil || filter.MaxPrice != nil) && filter.Currency == "" {
+		return filter, status.Error(
+			codes.InvalidArgument,
+			"price_currency should be set together with min_price or max_price",
+		)
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil &&
+		*filter.MinPrice > *filter.MaxPrice {
+		return filter, status.Error(
+			codes.InvalidArgument,
+			"min_price should not be greater than max_price",
+		)
+	}
+	err = verifyInteger(request.MaxDuration, 0, 480)
+	return filter, err
+}
+
 func (s *Server) FindManyCompanies(
 	ctx context.Context,
 	request *CompaniesRequest,
 ) (reply *CompaniesReply, err error) {
-	startValue := primitive.NilObjectID
-	if request.StartValue != nil {
-		startValue, err = primitive.ObjectIDFromHex(request.GetStartValue())
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
+	companiesSort, err := newCompanySort(request.GetSortBy(), request.GetDirection())
+	if err != nil {
+		return nil, err
 	}
-	var nPerPage int64 = 30
-	if request.NPerPage != nil {
-		nPerPage = *request.NPerPage
+	startKey, startValue, err := s.readPageToken(
+		request,
+		request.PageToken,
+		request.StartKey,
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := newCursor(companiesSort, startKey, startValue)
+	if err != nil {
+		return nil, err
 	}
-	db := s.Client.Database(database.DBName)
-	cursor, err := models.FindManyCompanies(ctx, db, startValue, nPerPage)
+	nPerPage, err := pageSize(request.NPerPage)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := newCompanyFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	companies, err := s.Companies.FindMany(
+		ctx,
+		filter,
+		companiesSort,
+		cursor,
+		pageLimit(nPerPage),
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	defer cursor.Close(ctx)
-	reply = &CompaniesReply{}
-	for cursor.Next(ctx) {
-		var companyModel models.Company
-		if err := cursor.Decode(&companyModel); err != nil {
+	companies, hasMore := trimPage(companies, nPerPage)
+	reply = &CompaniesReply{HasMore: &hasMore}
+	for idx := range companies {
+		reply.Companies = append(reply.Companies, newCompanyShort(&companies[idx]))
+	}
+	if request.GetWithTotalCount() {
+		totalCount, err := s.Companies.Count(ctx, filter)
+		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		companyID := companyModel.ID.Hex()
-		companyProto := &CompanyShort{
-			Id:               &companyID,
-			Name:             &companyModel.Name,
-			Type:             &companyModel.Type,
-			Localisation:     &companyModel.Localisation,
-			ShortDescription: &companyModel.ShortDescription,
-		}
-		reply.Companies = append(reply.Companies, companyProto)
+		reply.TotalCount = &totalCount
 	}
-	if len(reply.Companies) == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"There aren't any companies",
+	if hasMore {
+		last := &companies[len(companies)-1]
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			formatKey(companiesSort.CompanyKey(last)),
+			last.ID,
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return reply, nil
 }
@@ -414,45 +923,247 @@ func (s *Server) FindManyCompaniesByIds(
 			"At least one id should be provided in the request",
 		)
 	}
+	companiesSort, err := newCompanySort(request.GetSortBy(), request.GetDirection())
+	if err != nil {
+		return nil, err
+	}
+	startKey, startValue, err := s.readPageToken(
+		request,
+		request.PageToken,
+		request.StartKey,
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := newCursor(companiesSort, startKey, startValue)
+	if err != nil {
+		return nil, err
+	}
+	nPerPage, err := pageSize(request.NPerPage)
+	if err != nil {
+		return nil, err
+	}
+	companies, err := s.Companies.FindManyByIds(
+		ctx,
+		companiesIDS,
+		companiesSort,
+		cursor,
+		pageLimit(nPerPage),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	companies, hasMore := trimPage(companies, nPerPage)
+	reply = &CompaniesReply{HasMore: &hasMore}
+	for idx := range companies {
+		reply.Companies = append(reply.Companies, newCompanyShort(&companies[idx]))
+	}
+	if request.GetWithTotalCount() {
+		totalCount, err := s.Companies.CountByIds(ctx, companiesIDS)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.TotalCount = &totalCount
+	}
+	if hasMore {
+		last := &companies[len(companies)-1]
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			formatKey(companiesSort.CompanyKey(last)),
+			last.ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+func (s *Server) SearchCompanies(
+	ctx context.Context,
+	request *SearchCompaniesRequest,
+) (reply *CompaniesReply, err error) {
+	if request.GetQuery() == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"query should be set",
+		)
+	}
+	err = verifyString(request.Query, 100)
+	if err != nil {
+		return nil, err
+	}
+	startKey, startValueHex, err := s.readPageToken(
+		request,
+		request.PageToken,
+		formatFloatKey(request.StartScore),
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var startScore float64
 	startValue := primitive.NilObjectID
-	if request.StartValue != nil {
-		startValue, err = primitive.ObjectIDFromHex(request.GetStartValue())
+	if startValueHex != nil {
+		if startKey == nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"start_score should be set together with start_value",
+			)
+		}
+		startScore, err = parseFloatKey(*startKey)
+		if err != nil {
+			return nil, err
+		}
+		startValue, err = primitive.ObjectIDFromHex(*startValueHex)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 	}
-	var nPerPage int64 = 30
-	if request.NPerPage != nil {
-		nPerPage = *request.NPerPage
+	nPerPage, err := pageSize(request.NPerPage)
+	if err != nil {
+		return nil, err
 	}
-
-	db := s.Client.Database(database.DBName)
-	cursor, err := models.FindManyCompaniesByIds(ctx, db, companiesIDS, startValue, nPerPage)
+	companies, err := s.Companies.Search(
+		ctx,
+		request.GetQuery(),
+		startScore,
+		startValue,
+		pageLimit(nPerPage),
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	defer cursor.Close(ctx)
-	reply = &CompaniesReply{}
-	for cursor.Next(ctx) {
-		var companyModel models.Company
-		if err := cursor.Decode(&companyModel); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	companies, hasMore := trimPage(companies, nPerPage)
+	reply = &CompaniesReply{HasMore: &hasMore}
+	for idx := range companies {
+		companyProto := newCompanyShort(&companies[idx])
+		companyProto.Score = &companies[idx].Score
+		reply.Companies = append(reply.Companies, companyProto)
+	}
+	if hasMore {
+		last := &companies[len(companies)-1]
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			*formatFloatKey(&last.Score),
+			last.ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+func (s *Server) FindCompaniesNear(
+	ctx context.Context,
+	request *CompaniesNearRequest,
+) (reply *CompaniesReply, err error) {
+	point := &GeoPoint{Latitude: request.Latitude, Longitude: request.Longitude}
+	if request.Latitude == nil && request.Longitude == nil {
+		point = nil
+	}
+	if point == nil || request.Radius == nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"latitude, longitude and radius should be set",
+		)
+	}
+	err = verifyGeoPoint(point)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyFloat(request.Radius, 0, 100000)
+	if err != nil {
+		return nil, err
+	}
+	startKey, startValueHex, err := s.readPageToken(
+		request,
+		request.PageToken,
+		formatFloatKey(request.StartDistance),
+		request.StartValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var startDistance float64
+	startValue := primitive.NilObjectID
+	if startValueHex != nil {
+		if startKey == nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"start_distance should be set together with start_value",
+			)
 		}
-		companyID := companyModel.ID.Hex()
-		companyProto := &CompanyShort{
-			Id:               &companyID,
-			Name:             &companyModel.Name,
-			Type:             &companyModel.Type,
-			Localisation:     &companyModel.Localisation,
-			ShortDescription: &companyModel.ShortDescription,
+		startDistance, err = parseFloatKey(*startKey)
+		if err != nil {
+			return nil, err
 		}
+		startValue, err = primitive.ObjectIDFromHex(*startValueHex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	nPerPage, err := pageSize(request.NPerPage)
+	if err != nil {
+		return nil, err
+	}
+	companies, err := s.Companies.FindNear(
+		ctx,
+		newGeoPoint(point),
+		request.GetRadius(),
+		startDistance,
+		startValue,
+		pageLimit(nPerPage),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	companies, hasMore := trimPage(companies, nPerPage)
+	reply = &CompaniesReply{HasMore: &hasMore}
+	for idx := range companies {
+		companyProto := newCompanyShort(&companies[idx])
+		companyProto.Distance = &companies[idx].Distance
 		reply.Companies = append(reply.Companies, companyProto)
 	}
-	if len(reply.Companies) == 0 {
-		return nil, status.Error(
-			codes.NotFound,
-			"There aren't any companies",
+	if hasMore {
+		last := &companies[len(companies)-1]
+		reply.NextPageToken, err = s.nextPageToken(
+			request,
+			*formatFloatKey(&last.Distance),
+			last.ID,
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return reply, nil
 }
+
+func newCompanyShort(companyModel *models.Company) *CompanyShort {
+	companyID := companyModel.ID.Hex()
+	return &CompanyShort{
+		Id:               &companyID,
+		Name:             &companyModel.Name,
+		Type:             &companyModel.Type,
+		Localisation:     &companyModel.Localisation,
+		ShortDescription: &companyModel.ShortDescription,
+		Location:         newGeoPointProto(companyModel.Location),
+	}
+}
+
+func newGeoPoint(point *GeoPoint) *models.GeoPoint {
+	if point == nil {
+		return nil
+	}
+	return models.NewGeoPoint(point.GetLatitude(), point.GetLongitude())
+}
+
+func newGeoPointProto(point *models.GeoPoint) *GeoPoint {
+	if point == nil {
+		return nil
+	}
+	latitude, longitude := point.Latitude(), point.Longitude()
+	return &GeoPoint{Latitude: &latitude, Longitude: &longitude}
+}
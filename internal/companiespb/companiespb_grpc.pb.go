@@ -30,9 +30,31 @@ type ApiClient interface {
 	AddCompany(ctx context.Context, in *AddCompanyRequest, opts ...grpc.CallOption) (*AddCompanyReply, error)
 	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreCompany(ctx context.Context, in *RestoreCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOpeningHours(ctx context.Context, in *OpeningHoursRequest, opts ...grpc.CallOption) (*OpeningHours, error)
+	AddClosure(ctx context.Context, in *AddClosureRequest, opts ...grpc.CallOption) (*AddClosureReply, error)
+	DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListClosures(ctx context.Context, in *ClosuresRequest, opts ...grpc.CallOption) (*ClosuresReply, error)
+	IsOpenAt(ctx context.Context, in *IsOpenAtRequest, opts ...grpc.CallOption) (*IsOpenAtReply, error)
+	ListAvailableSlots(ctx context.Context, in *AvailableSlotsRequest, opts ...grpc.CallOption) (*AvailableSlotsReply, error)
+	AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffReply, error)
+	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteStaff(ctx context.Context, in *DeleteStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FindManyStaff(ctx context.Context, in *StaffRequest, opts ...grpc.CallOption) (*StaffReply, error)
+	AssignStaff(ctx context.Context, in *AssignStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryReply, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FindManyCategories(ctx context.Context, in *CategoriesRequest, opts ...grpc.CallOption) (*CategoriesReply, error)
 	FindOneCompany(ctx context.Context, in *CompanyRequest, opts ...grpc.CallOption) (*CompanyReply, error)
 	FindManyCompanies(ctx context.Context, in *CompaniesRequest, opts ...grpc.CallOption) (*CompaniesReply, error)
 	FindManyCompaniesByIds(ctx context.Context, in *CompaniesByIdsRequest, opts ...grpc.CallOption) (*CompaniesReply, error)
+	SearchCompanies(ctx context.Context, in *SearchCompaniesRequest, opts ...grpc.CallOption) (*CompaniesReply, error)
+	FindCompaniesNear(ctx context.Context, in *CompaniesNearRequest, opts ...grpc.CallOption) (*CompaniesReply, error)
+	ImportCompanies(ctx context.Context, opts ...grpc.CallOption) (Api_ImportCompaniesClient, error)
+	ExportCompanies(ctx context.Context, in *ExportCompaniesRequest, opts ...grpc.CallOption) (Api_ExportCompaniesClient, error)
+	ListAuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...grpc.CallOption) (*AuditEventsReply, error)
 }
 
 type apiClient struct {
@@ -106,6 +128,159 @@ func (c *apiClient) DeleteCompany(ctx context.Context, in *DeleteCompanyRequest,
 	return out, nil
 }
 
+func (c *apiClient) RestoreCompany(ctx context.Context, in *RestoreCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/RestoreCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/SetOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetOpeningHours(ctx context.Context, in *OpeningHoursRequest, opts ...grpc.CallOption) (*OpeningHours, error) {
+	out := new(OpeningHours)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/GetOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddClosure(ctx context.Context, in *AddClosureRequest, opts ...grpc.CallOption) (*AddClosureReply, error) {
+	out := new(AddClosureReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/AddClosure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/DeleteClosure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListClosures(ctx context.Context, in *ClosuresRequest, opts ...grpc.CallOption) (*ClosuresReply, error) {
+	out := new(ClosuresReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/ListClosures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IsOpenAt(ctx context.Context, in *IsOpenAtRequest, opts ...grpc.CallOption) (*IsOpenAtReply, error) {
+	out := new(IsOpenAtReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/IsOpenAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListAvailableSlots(ctx context.Context, in *AvailableSlotsRequest, opts ...grpc.CallOption) (*AvailableSlotsReply, error) {
+	out := new(AvailableSlotsReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/ListAvailableSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffReply, error) {
+	out := new(AddStaffReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/AddStaff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/UpdateStaff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) DeleteStaff(ctx context.Context, in *DeleteStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/DeleteStaff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) FindManyStaff(ctx context.Context, in *StaffRequest, opts ...grpc.CallOption) (*StaffReply, error) {
+	out := new(StaffReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/FindManyStaff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AssignStaff(ctx context.Context, in *AssignStaffRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/AssignStaff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryReply, error) {
+	out := new(AddCategoryReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/AddCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) FindManyCategories(ctx context.Context, in *CategoriesRequest, opts ...grpc.CallOption) (*CategoriesReply, error) {
+	out := new(CategoriesReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/FindManyCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiClient) FindOneCompany(ctx context.Context, in *CompanyRequest, opts ...grpc.CallOption) (*CompanyReply, error) {
 	out := new(CompanyReply)
 	err := c.cc.Invoke(ctx, "/companiespb.Api/FindOneCompany", in, out, opts...)
@@ -133,6 +308,99 @@ func (c *apiClient) FindManyCompaniesByIds(ctx context.Context, in *CompaniesByI
 	return out, nil
 }
 
+func (c *apiClient) SearchCompanies(ctx context.Context, in *SearchCompaniesRequest, opts ...grpc.CallOption) (*CompaniesReply, error) {
+	out := new(CompaniesReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/SearchCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) FindCompaniesNear(ctx context.Context, in *CompaniesNearRequest, opts ...grpc.CallOption) (*CompaniesReply, error) {
+	out := new(CompaniesReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/FindCompaniesNear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ImportCompanies(ctx context.Context, opts ...grpc.CallOption) (Api_ImportCompaniesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Api_ServiceDesc.Streams[0], "/companiespb.Api/ImportCompanies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiImportCompaniesClient{stream}
+	return x, nil
+}
+
+type Api_ImportCompaniesClient interface {
+	Send(*ImportCompanyRequest) error
+	CloseAndRecv() (*ImportCompaniesReply, error)
+	grpc.ClientStream
+}
+
+type apiImportCompaniesClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiImportCompaniesClient) Send(m *ImportCompanyRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiImportCompaniesClient) CloseAndRecv() (*ImportCompaniesReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCompaniesReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) ExportCompanies(ctx context.Context, in *ExportCompaniesRequest, opts ...grpc.CallOption) (Api_ExportCompaniesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Api_ServiceDesc.Streams[1], "/companiespb.Api/ExportCompanies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiExportCompaniesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_ExportCompaniesClient interface {
+	Recv() (*ExportedCompany, error)
+	grpc.ClientStream
+}
+
+type apiExportCompaniesClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiExportCompaniesClient) Recv() (*ExportedCompany, error) {
+	m := new(ExportedCompany)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) ListAuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...grpc.CallOption) (*AuditEventsReply, error) {
+	out := new(AuditEventsReply)
+	err := c.cc.Invoke(ctx, "/companiespb.Api/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServer is the server API for Api service.
 // All implementations must embed UnimplementedApiServer
 // for forward compatibility
@@ -144,9 +412,31 @@ type ApiServer interface {
 	AddCompany(context.Context, *AddCompanyRequest) (*AddCompanyReply, error)
 	UpdateCompany(context.Context, *UpdateCompanyRequest) (*emptypb.Empty, error)
 	DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error)
+	RestoreCompany(context.Context, *RestoreCompanyRequest) (*emptypb.Empty, error)
+	SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*emptypb.Empty, error)
+	GetOpeningHours(context.Context, *OpeningHoursRequest) (*OpeningHours, error)
+	AddClosure(context.Context, *AddClosureRequest) (*AddClosureReply, error)
+	DeleteClosure(context.Context, *DeleteClosureRequest) (*emptypb.Empty, error)
+	ListClosures(context.Context, *ClosuresRequest) (*ClosuresReply, error)
+	IsOpenAt(context.Context, *IsOpenAtRequest) (*IsOpenAtReply, error)
+	ListAvailableSlots(context.Context, *AvailableSlotsRequest) (*AvailableSlotsReply, error)
+	AddStaff(context.Context, *AddStaffRequest) (*AddStaffReply, error)
+	UpdateStaff(context.Context, *UpdateStaffRequest) (*emptypb.Empty, error)
+	DeleteStaff(context.Context, *DeleteStaffRequest) (*emptypb.Empty, error)
+	FindManyStaff(context.Context, *StaffRequest) (*StaffReply, error)
+	AssignStaff(context.Context, *AssignStaffRequest) (*emptypb.Empty, error)
+	AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryReply, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	FindManyCategories(context.Context, *CategoriesRequest) (*CategoriesReply, error)
 	FindOneCompany(context.Context, *CompanyRequest) (*CompanyReply, error)
 	FindManyCompanies(context.Context, *CompaniesRequest) (*CompaniesReply, error)
 	FindManyCompaniesByIds(context.Context, *CompaniesByIdsRequest) (*CompaniesReply, error)
+	SearchCompanies(context.Context, *SearchCompaniesRequest) (*CompaniesReply, error)
+	FindCompaniesNear(context.Context, *CompaniesNearRequest) (*CompaniesReply, error)
+	ImportCompanies(Api_ImportCompaniesServer) error
+	ExportCompanies(*ExportCompaniesRequest, Api_ExportCompaniesServer) error
+	ListAuditEvents(context.Context, *AuditEventsRequest) (*AuditEventsReply, error)
 	mustEmbedUnimplementedApiServer()
 }
 
@@ -175,6 +465,57 @@ func (UnimplementedApiServer) UpdateCompany(context.Context, *UpdateCompanyReque
 func (UnimplementedApiServer) DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
 }
+func (UnimplementedApiServer) RestoreCompany(context.Context, *RestoreCompanyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCompany not implemented")
+}
+func (UnimplementedApiServer) SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpeningHours not implemented")
+}
+func (UnimplementedApiServer) GetOpeningHours(context.Context, *OpeningHoursRequest) (*OpeningHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpeningHours not implemented")
+}
+func (UnimplementedApiServer) AddClosure(context.Context, *AddClosureRequest) (*AddClosureReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClosure not implemented")
+}
+func (UnimplementedApiServer) DeleteClosure(context.Context, *DeleteClosureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClosure not implemented")
+}
+func (UnimplementedApiServer) ListClosures(context.Context, *ClosuresRequest) (*ClosuresReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClosures not implemented")
+}
+func (UnimplementedApiServer) IsOpenAt(context.Context, *IsOpenAtRequest) (*IsOpenAtReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsOpenAt not implemented")
+}
+func (UnimplementedApiServer) ListAvailableSlots(context.Context, *AvailableSlotsRequest) (*AvailableSlotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableSlots not implemented")
+}
+func (UnimplementedApiServer) AddStaff(context.Context, *AddStaffRequest) (*AddStaffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaff not implemented")
+}
+func (UnimplementedApiServer) UpdateStaff(context.Context, *UpdateStaffRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaff not implemented")
+}
+func (UnimplementedApiServer) DeleteStaff(context.Context, *DeleteStaffRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStaff not implemented")
+}
+func (UnimplementedApiServer) FindManyStaff(context.Context, *StaffRequest) (*StaffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindManyStaff not implemented")
+}
+func (UnimplementedApiServer) AssignStaff(context.Context, *AssignStaffRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignStaff not implemented")
+}
+func (UnimplementedApiServer) AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
+func (UnimplementedApiServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedApiServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedApiServer) FindManyCategories(context.Context, *CategoriesRequest) (*CategoriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindManyCategories not implemented")
+}
 func (UnimplementedApiServer) FindOneCompany(context.Context, *CompanyRequest) (*CompanyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindOneCompany not implemented")
 }
@@ -184,6 +525,21 @@ func (UnimplementedApiServer) FindManyCompanies(context.Context, *CompaniesReque
 func (UnimplementedApiServer) FindManyCompaniesByIds(context.Context, *CompaniesByIdsRequest) (*CompaniesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindManyCompaniesByIds not implemented")
 }
+func (UnimplementedApiServer) SearchCompanies(context.Context, *SearchCompaniesRequest) (*CompaniesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCompanies not implemented")
+}
+func (UnimplementedApiServer) FindCompaniesNear(context.Context, *CompaniesNearRequest) (*CompaniesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCompaniesNear not implemented")
+}
+func (UnimplementedApiServer) ImportCompanies(Api_ImportCompaniesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCompanies not implemented")
+}
+func (UnimplementedApiServer) ExportCompanies(*ExportCompaniesRequest, Api_ExportCompaniesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCompanies not implemented")
+}
+func (UnimplementedApiServer) ListAuditEvents(context.Context, *AuditEventsRequest) (*AuditEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedApiServer) mustEmbedUnimplementedApiServer() {}
 
 // UnsafeApiServer may be embedded to opt out of forward compatibility for this service.
@@ -323,6 +679,312 @@ func _Api_DeleteCompany_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_RestoreCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RestoreCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/RestoreCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RestoreCompany(ctx, req.(*RestoreCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/SetOpeningHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SetOpeningHours(ctx, req.(*SetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/GetOpeningHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetOpeningHours(ctx, req.(*OpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/AddClosure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddClosure(ctx, req.(*AddClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_DeleteClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).DeleteClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/DeleteClosure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).DeleteClosure(ctx, req.(*DeleteClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListClosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListClosures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/ListClosures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListClosures(ctx, req.(*ClosuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IsOpenAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsOpenAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IsOpenAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/IsOpenAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IsOpenAt(ctx, req.(*IsOpenAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/ListAvailableSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListAvailableSlots(ctx, req.(*AvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/AddStaff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddStaff(ctx, req.(*AddStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).UpdateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/UpdateStaff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_DeleteStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).DeleteStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/DeleteStaff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).DeleteStaff(ctx, req.(*DeleteStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_FindManyStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).FindManyStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/FindManyStaff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).FindManyStaff(ctx, req.(*StaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AssignStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AssignStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/AssignStaff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AssignStaff(ctx, req.(*AssignStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/AddCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddCategory(ctx, req.(*AddCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_FindManyCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).FindManyCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/FindManyCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).FindManyCategories(ctx, req.(*CategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Api_FindOneCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompanyRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +1039,107 @@ func _Api_FindManyCompaniesByIds_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_SearchCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SearchCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/SearchCompanies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SearchCompanies(ctx, req.(*SearchCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_FindCompaniesNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompaniesNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).FindCompaniesNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/FindCompaniesNear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).FindCompaniesNear(ctx, req.(*CompaniesNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ImportCompanies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiServer).ImportCompanies(&apiImportCompaniesServer{stream})
+}
+
+type Api_ImportCompaniesServer interface {
+	SendAndClose(*ImportCompaniesReply) error
+	Recv() (*ImportCompanyRequest, error)
+	grpc.ServerStream
+}
+
+type apiImportCompaniesServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiImportCompaniesServer) SendAndClose(m *ImportCompaniesReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiImportCompaniesServer) Recv() (*ImportCompanyRequest, error) {
+	m := new(ImportCompanyRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Api_ExportCompanies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCompaniesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).ExportCompanies(m, &apiExportCompaniesServer{stream})
+}
+
+type Api_ExportCompaniesServer interface {
+	Send(*ExportedCompany) error
+	grpc.ServerStream
+}
+
+type apiExportCompaniesServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiExportCompaniesServer) Send(m *ExportedCompany) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/companiespb.Api/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListAuditEvents(ctx, req.(*AuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Api_ServiceDesc is the grpc.ServiceDesc for Api service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -412,6 +1175,74 @@ var Api_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCompany",
 			Handler:    _Api_DeleteCompany_Handler,
 		},
+		{
+			MethodName: "RestoreCompany",
+			Handler:    _Api_RestoreCompany_Handler,
+		},
+		{
+			MethodName: "SetOpeningHours",
+			Handler:    _Api_SetOpeningHours_Handler,
+		},
+		{
+			MethodName: "GetOpeningHours",
+			Handler:    _Api_GetOpeningHours_Handler,
+		},
+		{
+			MethodName: "AddClosure",
+			Handler:    _Api_AddClosure_Handler,
+		},
+		{
+			MethodName: "DeleteClosure",
+			Handler:    _Api_DeleteClosure_Handler,
+		},
+		{
+			MethodName: "ListClosures",
+			Handler:    _Api_ListClosures_Handler,
+		},
+		{
+			MethodName: "IsOpenAt",
+			Handler:    _Api_IsOpenAt_Handler,
+		},
+		{
+			MethodName: "ListAvailableSlots",
+			Handler:    _Api_ListAvailableSlots_Handler,
+		},
+		{
+			MethodName: "AddStaff",
+			Handler:    _Api_AddStaff_Handler,
+		},
+		{
+			MethodName: "UpdateStaff",
+			Handler:    _Api_UpdateStaff_Handler,
+		},
+		{
+			MethodName: "DeleteStaff",
+			Handler:    _Api_DeleteStaff_Handler,
+		},
+		{
+			MethodName: "FindManyStaff",
+			Handler:    _Api_FindManyStaff_Handler,
+		},
+		{
+			MethodName: "AssignStaff",
+			Handler:    _Api_AssignStaff_Handler,
+		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _Api_AddCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Api_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Api_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "FindManyCategories",
+			Handler:    _Api_FindManyCategories_Handler,
+		},
 		{
 			MethodName: "FindOneCompany",
 			Handler:    _Api_FindOneCompany_Handler,
@@ -424,7 +1255,30 @@ var Api_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindManyCompaniesByIds",
 			Handler:    _Api_FindManyCompaniesByIds_Handler,
 		},
+		{
+			MethodName: "SearchCompanies",
+			Handler:    _Api_SearchCompanies_Handler,
+		},
+		{
+			MethodName: "FindCompaniesNear",
+			Handler:    _Api_FindCompaniesNear_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Api_ListAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCompanies",
+			Handler:       _Api_ImportCompanies_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCompanies",
+			Handler:       _Api_ExportCompanies_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "companiespb.proto",
 }
@@ -0,0 +1,2826 @@
+package companiespb_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/companiespb"
+	"github.com/msik-404/micro-appoint-companies/internal/events"
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+	"github.com/msik-404/micro-appoint-companies/internal/models/memory"
+	"github.com/msik-404/micro-appoint-companies/internal/pagetoken"
+)
+
+// newClient starts companiespb.Server backed by in-memory storage on a
+// bufconn listener and returns a client connected to it.
+func newClient(t *testing.T) companiespb.ApiClient {
+	t.Helper()
+	client, _ := newClientWithStore(t)
+	return client
+}
+
+// newClientWithStore is newClient which also returns storage of the server.
+func newClientWithStore(t *testing.T) (companiespb.ApiClient, *memory.Store) {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	store := memory.NewStore()
+	s := grpc.NewServer()
+	companiespb.RegisterApiServer(s, &companiespb.Server{
+		Companies:  store.Companies(),
+		Services:   store.Services(),
+		Closures:   store.Closures(),
+		Staff:      store.Staff(),
+		Categories: store.Categories(),
+		Audit:      store.Audit(),
+		Outbox:     store.Outbox(),
+		Tx:         store,
+		PageTokens: pagetoken.NewSigner([]byte("secret")),
+	})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return companiespb.NewApiClient(conn), store
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("expected code %s, got %v", code, err)
+	}
+}
+
+func addCompany(t *testing.T, client companiespb.ApiClient, name string) string {
+	t.Helper()
+	reply, err := client.AddCompany(context.Background(), &companiespb.AddCompanyRequest{
+		Name:             proto.String(name),
+		Type:             proto.String("Hairdresser"),
+		Localisation:     proto.String("Warsaw"),
+		ShortDescription: proto.String("short " + name),
+		LongDescription:  proto.String("long " + name),
+		Currency:         proto.String("PLN"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reply.GetId()
+}
+
+// pln returns price in grosze.
+func pln(amount int64) *companiespb.Money {
+	return &companiespb.Money{Amount: proto.Int64(amount), Currency: proto.String("PLN")}
+}
+
+func addService(
+	t *testing.T,
+	client companiespb.ApiClient,
+	companyID string,
+	name string,
+) {
+	t.Helper()
+	_, err := client.AddService(context.Background(), &companiespb.AddServiceRequest{
+		CompanyId:   proto.String(companyID),
+		Name:        proto.String(name),
+		Price:       pln(100),
+		Duration:    proto.Int32(30),
+		Description: proto.String("description " + name),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddCompany(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		t.Fatalf("invalid id returned: %v", err)
+	}
+
+	_, err := client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name: proto.String("Barber"),
+	})
+	requireCode(t, err, codes.AlreadyExists)
+
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name: proto.String("This name is far too long to be accepted"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateCompany(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addCompany(t, client, "Salon")
+
+	_, err := client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:   proto.String(id),
+		Type: proto.String("Barbershop"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetType() != "Barbershop" || company.GetName() != "Barber" {
+		t.Fatalf("unexpected company after update: %v", company)
+	}
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:   proto.String(id),
+		Name: proto.String("Salon"),
+	})
+	requireCode(t, err, codes.AlreadyExists)
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:   proto.String(primitive.NewObjectID().Hex()),
+		Type: proto.String("Barbershop"),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id: proto.String("not an id"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateCompanyVersion(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := company.GetVersion()
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:      proto.String(id),
+		Type:    proto.String("Barbershop"),
+		Version: proto.Int64(version),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// second admin still holds the old version
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:      proto.String(id),
+		Type:    proto.String("Salon"),
+		Version: proto.Int64(version),
+	})
+	requireCode(t, err, codes.Aborted)
+
+	company, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetType() != "Barbershop" || company.GetVersion() != version+1 {
+		t.Fatalf("unexpected company after update: %v", company)
+	}
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:      proto.String(primitive.NewObjectID().Hex()),
+		Version: proto.Int64(version),
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestDeleteCompany(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	_, err := client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(id)})
+	requireCode(t, err, codes.NotFound)
+
+	companies, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(companies.GetCompanies()) != 0 {
+		t.Fatalf("deleted company should be hidden: %v", companies.GetCompanies())
+	}
+
+	_, err = client.AddService(ctx, &companiespb.AddServiceRequest{
+		CompanyId: proto.String(id),
+		Name:      proto.String("Haircut"),
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestRestoreCompany(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+
+	_, err := client.RestoreCompany(ctx, &companiespb.RestoreCompanyRequest{Id: proto.String(id)})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{CompanyId: proto.String(id)})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.RestoreCompany(ctx, &companiespb.RestoreCompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetName() != "Barber" || len(company.GetServices()) != 1 {
+		t.Fatalf("company was not restored with its services: %v", company)
+	}
+
+	// deleted companies still hold their name
+	_, err = client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{Name: proto.String("Barber")})
+	requireCode(t, err, codes.AlreadyExists)
+}
+
+func TestFindOneCompany(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	for i := 0; i < 12; i++ {
+		addService(t, client, id, "Haircut")
+	}
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetName() != "Barber" || company.GetLongDescription() != "long Barber" {
+		t.Fatalf("unexpected company: %v", company)
+	}
+	if len(company.GetServices()) != 10 || company.GetServicesTotalCount() != 12 {
+		t.Fatalf(
+			"expected 10 of 12 services, got %d of %d",
+			len(company.GetServices()),
+			company.GetServicesTotalCount(),
+		)
+	}
+	// the rest of services is available through FindManyServices
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		PageToken: company.ServicesNextPageToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services.GetServices()) != 2 || services.GetHasMore() {
+		t.Fatalf("unexpected services: %v", services)
+	}
+
+	company, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id:                proto.String(id),
+		ServicesNPerPage:  proto.Int64(12),
+		ServicesDirection: companiespb.SortDirection_ASCENDING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(company.GetServices()) != 12 || company.ServicesNextPageToken != nil {
+		t.Fatalf("expected all services, got %d", len(company.GetServices()))
+	}
+	if company.GetServices()[0].GetId() > company.GetServices()[1].GetId() {
+		t.Fatal("services should be sorted oldest first")
+	}
+
+	_, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id:               proto.String(id),
+		ServicesNPerPage: proto.Int64(101),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id: proto.String(primitive.NewObjectID().Hex()),
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestFindManyCompanies(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	reply, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 0 || reply.GetHasMore() {
+		t.Fatalf("expected empty reply, got %v", reply)
+	}
+
+	var ids []string
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		ids = append(ids, addCompany(t, client, name))
+	}
+	var seen []string
+	request := &companiespb.CompaniesRequest{NPerPage: proto.Int64(2)}
+	for {
+		reply, err := client.FindManyCompanies(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, company := range reply.GetCompanies() {
+			seen = append(seen, company.GetId())
+		}
+		if !reply.GetHasMore() {
+			break
+		}
+		last := reply.GetCompanies()[len(reply.GetCompanies())-1]
+		request.StartValue = last.Id
+	}
+	if len(seen) != len(ids) {
+		t.Fatalf("expected %d companies, got %d", len(ids), len(seen))
+	}
+	for i := range ids {
+		if seen[i] != ids[len(ids)-1-i] {
+			t.Fatalf("companies are not in descending id order: %v", seen)
+		}
+	}
+
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		StartValue: proto.String("not an id"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestFindManyCompaniesFilters(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	barber := addCompany(t, client, "Barber Shop")
+	addService(t, client, barber, "Haircut")
+	bar := addCompany(t, client, "Bar Nails")
+	_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+		CompanyId:   proto.String(bar),
+		Name:        proto.String("Manicure"),
+		Price:       pln(300),
+		Duration:    proto.Int32(90),
+		Description: proto.String("description"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name:             proto.String("Clinic"),
+		Type:             proto.String("Dentist"),
+		Localisation:     proto.String("Warsaw"),
+		ShortDescription: proto.String("short"),
+		LongDescription:  proto.String("long"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := func(request *companiespb.CompaniesRequest) []string {
+		t.Helper()
+		reply, err := client.FindManyCompanies(ctx, request)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, company := range reply.GetCompanies() {
+			ids = append(ids, company.GetId())
+		}
+		return ids
+	}
+
+	got := ids(&companiespb.CompaniesRequest{Types: []string{"Hairdresser"}})
+	if len(got) != 2 || got[0] != bar || got[1] != barber {
+		t.Fatalf("unexpected companies filtered by type: %v", got)
+	}
+	got = ids(&companiespb.CompaniesRequest{NamePrefix: proto.String("Barb")})
+	if len(got) != 1 || got[0] != barber {
+		t.Fatalf("unexpected companies filtered by name prefix: %v", got)
+	}
+	got = ids(&companiespb.CompaniesRequest{NamePrefix: proto.String("Bar.")})
+	if len(got) != 0 {
+		t.Fatalf("name prefix should be matched literally: %v", got)
+	}
+	got = ids(&companiespb.CompaniesRequest{
+		MinPrice:      proto.Int64(200),
+		PriceCurrency: proto.String("PLN"),
+	})
+	if len(got) != 1 || got[0] != bar {
+		t.Fatalf("unexpected companies filtered by price: %v", got)
+	}
+	// No single service of bar is both expensive and short.
+	got = ids(&companiespb.CompaniesRequest{
+		MinPrice:      proto.Int64(200),
+		MaxDuration:   proto.Int32(60),
+		PriceCurrency: proto.String("PLN"),
+	})
+	if len(got) != 0 {
+		t.Fatalf("unexpected companies filtered by price and duration: %v", got)
+	}
+	got = ids(&companiespb.CompaniesRequest{
+		MaxPrice:      proto.Int64(1000),
+		PriceCurrency: proto.String("PLN"),
+		StartValue:    proto.String(bar),
+	})
+	if len(got) != 1 || got[0] != barber {
+		t.Fatalf("filters should compose with pagination: %v", got)
+	}
+
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		MinPrice:      proto.Int64(500),
+		MaxPrice:      proto.Int64(100),
+		PriceCurrency: proto.String("PLN"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		MinPrice: proto.Int64(100),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		MaxDuration: proto.Int32(0),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestFindManyCompaniesByIds(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	a := addCompany(t, client, "A")
+	addCompany(t, client, "B")
+	c := addCompany(t, client, "C")
+
+	reply, err := client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{
+		Ids: []string{a, c},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	companies := reply.GetCompanies()
+	if len(companies) != 2 || companies[0].GetId() != c || companies[1].GetId() != a {
+		t.Fatalf("unexpected companies: %v", companies)
+	}
+
+	reply, err = client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{
+		Ids:        []string{a, c},
+		StartValue: proto.String(c),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 1 || reply.GetCompanies()[0].GetId() != a {
+		t.Fatalf("unexpected companies: %v", reply.GetCompanies())
+	}
+
+	_, err = client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	reply, err = client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{
+		Ids: []string{primitive.NewObjectID().Hex()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 0 {
+		t.Fatalf("expected no companies, got %v", reply.GetCompanies())
+	}
+}
+
+func TestNPerPage(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "A")
+	for _, nPerPage := range []int64{0, -1, 101} {
+		_, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+			NPerPage: proto.Int64(nPerPage),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+
+		_, err = client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{
+			Ids:      []string{id},
+			NPerPage: proto.Int64(nPerPage),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+
+		_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+			CompanyId: proto.String(id),
+			NPerPage:  proto.Int64(nPerPage),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+
+		_, err = client.ListAuditEvents(ctx, &companiespb.AuditEventsRequest{
+			CompanyId: proto.String(id),
+			NPerPage:  proto.Int64(nPerPage),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	}
+
+	reply, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		NPerPage: proto.Int64(100),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 1 {
+		t.Fatalf("expected one company, got %v", reply.GetCompanies())
+	}
+}
+
+func TestAddService(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+
+	_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+		CompanyId: proto.String(primitive.NewObjectID().Hex()),
+		Name:      proto.String("Haircut"),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.AddService(ctx, &companiespb.AddServiceRequest{
+		CompanyId: proto.String(id),
+		Duration:  proto.Int32(481),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateService(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceID := services.GetServices()[0].GetId()
+
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(serviceID),
+		Price:     pln(150),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	services, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := services.GetServices()[0]
+	if service.GetPrice().GetAmount() != 150 || service.GetName() != "Haircut" {
+		t.Fatalf("unexpected service after update: %v", service)
+	}
+
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(primitive.NewObjectID().Hex()),
+		Price:     pln(150),
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestServiceVersion(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := services.GetServices()[0]
+
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        service.Id,
+		Price:     pln(150),
+		Version:   service.Version,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        service.Id,
+		Price:     pln(200),
+		Version:   service.Version,
+	})
+	requireCode(t, err, codes.Aborted)
+
+	_, err = client.DeleteService(ctx, &companiespb.DeleteServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        service.Id,
+		Version:   service.Version,
+	})
+	requireCode(t, err, codes.Aborted)
+
+	_, err = client.DeleteService(ctx, &companiespb.DeleteServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        service.Id,
+		Version:   proto.Int64(service.GetVersion() + 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteService(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := &companiespb.DeleteServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        services.GetServices()[0].Id,
+	}
+	_, err = client.DeleteService(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteService(ctx, request)
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestFindManyServices(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	reply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetServices()) != 0 || reply.GetHasMore() {
+		t.Fatalf("expected empty reply, got %v", reply)
+	}
+	_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(primitive.NewObjectID().Hex()),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	for _, name := range []string{"A", "B", "C"} {
+		addService(t, client, id, name)
+	}
+	reply, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		NPerPage:  proto.Int64(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	services := reply.GetServices()
+	if len(services) != 2 || services[0].GetName() != "C" || services[1].GetName() != "B" {
+		t.Fatalf("unexpected first page: %v", services)
+	}
+	reply, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:  proto.String(id),
+		StartValue: services[1].Id,
+		NPerPage:   proto.Int64(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetServices()) != 1 || reply.GetServices()[0].GetName() != "A" {
+		t.Fatalf("unexpected second page: %v", reply.GetServices())
+	}
+
+	_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String("not an id"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestSortedCompanies(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"C", "A", "D", "B"} {
+		addCompany(t, client, name)
+	}
+	var names []string
+	request := &companiespb.CompaniesRequest{
+		SortBy:    companiespb.CompanySortField_COMPANY_NAME,
+		Direction: companiespb.SortDirection_ASCENDING,
+		NPerPage:  proto.Int64(3),
+	}
+	for {
+		reply, err := client.FindManyCompanies(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		companies := reply.GetCompanies()
+		for _, company := range companies {
+			names = append(names, company.GetName())
+		}
+		if !reply.GetHasMore() {
+			break
+		}
+		last := companies[len(companies)-1]
+		request.StartKey = last.Name
+		request.StartValue = last.Id
+	}
+	if strings.Join(names, "") != "ABCD" {
+		t.Fatalf("companies are not sorted by name: %v", names)
+	}
+
+	_, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		SortBy:     companiespb.CompanySortField_COMPANY_NAME,
+		StartValue: proto.String(primitive.NewObjectID().Hex()),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		SortBy: companiespb.CompanySortField(42),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestServicePrices(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	reply, err := client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name: proto.String("Clinic"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := reply.GetId()
+	addPriced := func(name string, price *companiespb.Money) error {
+		_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+			CompanyId: proto.String(id),
+			Name:      proto.String(name),
+			Price:     price,
+			Duration:  proto.Int32(30),
+		})
+		return err
+	}
+	err = addPriced("Checkup", &companiespb.Money{Amount: proto.Int64(100)})
+	requireCode(t, err, codes.FailedPrecondition)
+	for _, currency := range []string{"pln", "ABC", ""} {
+		err = addPriced("Checkup", &companiespb.Money{
+			Amount:   proto.Int64(100),
+			Currency: proto.String(currency),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	}
+	err = addPriced("Checkup", &companiespb.Money{Amount: proto.Int64(-1), Currency: proto.String("EUR")})
+	requireCode(t, err, codes.InvalidArgument)
+	if err := addPriced("Checkup", &companiespb.Money{
+		Amount:   proto.Int64(2500),
+		Currency: proto.String("EUR"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:       proto.String(id),
+		Currency: proto.String("PLN"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := addPriced("Consultation", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	servicesReply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_CREATED_AT,
+		Direction: companiespb.SortDirection_ASCENDING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkup := servicesReply.GetServices()[0]
+	consultation := servicesReply.GetServices()[1]
+	if checkup.GetPrice().GetCurrency() != "EUR" ||
+		consultation.GetPrice().GetAmount() != 0 ||
+		consultation.GetPrice().GetCurrency() != "PLN" {
+		t.Fatalf("unexpected prices: %v", servicesReply.GetServices())
+	}
+	// Currency of the service is kept when only amount is updated.
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        checkup.Id,
+		Price:     &companiespb.Money{Amount: proto.Int64(3000)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	companies := func(currency string, maxPrice int64) int {
+		t.Helper()
+		reply, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+			PriceCurrency: proto.String(currency),
+			MaxPrice:      proto.Int64(maxPrice),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(reply.GetCompanies())
+	}
+	if companies("EUR", 3000) != 1 || companies("EUR", 2999) != 0 || companies("USD", 3000) != 0 {
+		t.Fatal("unexpected companies filtered by price in currency")
+	}
+}
+
+func TestServiceVariants(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Barber")
+
+	variant := func(name string, amount int64, duration int32) *companiespb.ServiceVariant {
+		return &companiespb.ServiceVariant{
+			Name:     proto.String(name),
+			Price:    &companiespb.Money{Amount: proto.Int64(amount)},
+			Duration: proto.Int32(duration),
+		}
+	}
+	addOn := func(name string, amount int64, duration int32) *companiespb.ServiceAddOn {
+		return &companiespb.ServiceAddOn{
+			Name:     proto.String(name),
+			Price:    &companiespb.Money{Amount: proto.Int64(amount)},
+			Duration: proto.Int32(duration),
+		}
+	}
+	addHaircut := func(
+		variants []*companiespb.ServiceVariant,
+		addOns []*companiespb.ServiceAddOn,
+	) error {
+		_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+			CompanyId: proto.String(id),
+			Name:      proto.String("Haircut"),
+			Price:     pln(5000),
+			Duration:  proto.Int32(30),
+			Variants:  variants,
+			AddOns:    addOns,
+		})
+		return err
+	}
+	for _, test := range []struct {
+		name     string
+		variants []*companiespb.ServiceVariant
+		addOns   []*companiespb.ServiceAddOn
+	}{
+		{"duplicated variant", []*companiespb.ServiceVariant{
+			variant("Short", 5000, 30), variant("Short", 6000, 45),
+		}, nil},
+		{"variant without duration", []*companiespb.ServiceVariant{variant("Short", 5000, 0)}, nil},
+		{"too long variant", []*companiespb.ServiceVariant{variant("Short", 5000, 481)}, nil},
+		{"add-on without name", nil, []*companiespb.ServiceAddOn{addOn("", 1000, 0)}},
+		{"add-on with negative duration", nil, []*companiespb.ServiceAddOn{addOn("Wash", 1000, -1)}},
+		{"variant with only name", []*companiespb.ServiceVariant{{
+			Name: proto.String("Short"),
+		}}, nil},
+		{"add-on with only name", nil, []*companiespb.ServiceAddOn{{
+			Name: proto.String("Wash"),
+		}}},
+		{"variant without amount", []*companiespb.ServiceVariant{{
+			Name:     proto.String("Short"),
+			Price:    &companiespb.Money{Currency: proto.String("PLN")},
+			Duration: proto.Int32(30),
+		}}, nil},
+		{"other currency", nil, []*companiespb.ServiceAddOn{{
+			Name:     proto.String("Wash"),
+			Price:    &companiespb.Money{Amount: proto.Int64(1000), Currency: proto.String("EUR")},
+			Duration: proto.Int32(0),
+		}}},
+	} {
+		err := addHaircut(test.variants, test.addOns)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: unexpected error %v", test.name, err)
+		}
+	}
+	err := addHaircut(
+		[]*companiespb.ServiceVariant{variant("Short", 5000, 30), variant("Long", 8000, 60)},
+		[]*companiespb.ServiceAddOn{addOn("Wash", 1000, 15), addOn("Styling gel", 500, 0)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findHaircut := func() *companiespb.Service {
+		t.Helper()
+		reply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+			CompanyId: proto.String(id),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reply.GetServices()[0]
+	}
+	haircut := findHaircut()
+	variants := haircut.GetVariants()
+	addOns := haircut.GetAddOns()
+	if len(variants) != 2 || variants[1].GetName() != "Long" ||
+		variants[1].GetPrice().GetAmount() != 8000 ||
+		variants[1].GetPrice().GetCurrency() != "PLN" ||
+		variants[1].GetDuration() != 60 ||
+		len(addOns) != 2 || addOns[0].GetDuration() != 15 ||
+		addOns[1].GetPrice().GetCurrency() != "PLN" {
+		t.Fatalf("unexpected variants and add-ons: %v", haircut)
+	}
+
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        haircut.Id,
+		Variants: &companiespb.ServiceVariants{Variants: []*companiespb.ServiceVariant{
+			variant("Medium", 6500, 45),
+		}},
+		Version: haircut.Version,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	haircut = findHaircut()
+	if len(haircut.GetVariants()) != 1 || haircut.GetVariants()[0].GetName() != "Medium" ||
+		len(haircut.GetAddOns()) != 2 {
+		t.Fatalf("only variants should be replaced: %v", haircut)
+	}
+	// Changing currency of the service requires repricing of its options.
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        haircut.Id,
+		Price:     &companiespb.Money{Amount: proto.Int64(1200), Currency: proto.String("EUR")},
+		Variants:  &companiespb.ServiceVariants{},
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        haircut.Id,
+		Variants:  &companiespb.ServiceVariants{},
+		AddOns:    &companiespb.ServiceAddOns{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	haircut = findHaircut()
+	if len(haircut.GetVariants()) != 0 || len(haircut.GetAddOns()) != 0 {
+		t.Fatalf("variants and add-ons were not removed: %v", haircut)
+	}
+}
+
+func TestSortedServices(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	for _, price := range []int64{200, 100, 200, 300} {
+		_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+			CompanyId:   proto.String(id),
+			Name:        proto.String(fmt.Sprint(price)),
+			Price:       pln(price),
+			Duration:    proto.Int32(30),
+			Description: proto.String("description"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	var services []*companiespb.Service
+	request := &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_PRICE,
+		NPerPage:  proto.Int64(1),
+	}
+	for {
+		reply, err := client.FindManyServices(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		last := reply.GetServices()[0]
+		services = append(services, last)
+		if !reply.GetHasMore() {
+			break
+		}
+		request.StartKey = proto.String(fmt.Sprintf(
+			"%s %d",
+			last.GetPrice().GetCurrency(),
+			last.GetPrice().GetAmount(),
+		))
+		request.StartValue = last.Id
+	}
+	// Services with the same price are ordered by id in the same direction.
+	if len(services) != 4 ||
+		services[0].GetPrice().GetAmount() != 300 ||
+		services[1].GetPrice().GetAmount() != 200 ||
+		services[2].GetPrice().GetAmount() != 200 ||
+		services[3].GetPrice().GetAmount() != 100 ||
+		services[1].GetId() < services[2].GetId() {
+		t.Fatalf("services are not sorted by price: %v", services)
+	}
+
+	var names []string
+	request = &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_NAME,
+		Direction: companiespb.SortDirection_ASCENDING,
+		NPerPage:  proto.Int64(1),
+	}
+	for {
+		reply, err := client.FindManyServices(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, reply.GetServices()[0].GetName())
+		if reply.NextPageToken == nil {
+			break
+		}
+		request.PageToken = reply.NextPageToken
+	}
+	if strings.Join(names, ",") != "100,200,200,300" {
+		t.Fatalf("services are not sorted by name: %v", names)
+	}
+
+	reply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:  proto.String(id),
+		SortBy:     companiespb.ServiceSortField_SERVICE_NAME,
+		Direction:  companiespb.SortDirection_ASCENDING,
+		StartKey:   proto.String(services[1].GetName()),
+		StartValue: services[1].Id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetServices()) != 1 || reply.GetServices()[0].GetName() != "300" {
+		t.Fatalf("unexpected services after start_key: %v", reply.GetServices())
+	}
+
+	// Prices in different currencies are not comparable, they are ordered
+	// by currency first.
+	_, err = client.AddService(ctx, &companiespb.AddServiceRequest{
+		CompanyId: proto.String(id),
+		Name:      proto.String("150 EUR"),
+		Price: &companiespb.Money{
+			Amount:   proto.Int64(150),
+			Currency: proto.String("EUR"),
+		},
+		Duration: proto.Int32(30),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var prices []string
+	request = &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_PRICE,
+		Direction: companiespb.SortDirection_ASCENDING,
+		NPerPage:  proto.Int64(2),
+	}
+	for {
+		reply, err := client.FindManyServices(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, service := range reply.GetServices() {
+			prices = append(prices, fmt.Sprintf(
+				"%s %d",
+				service.GetPrice().GetCurrency(),
+				service.GetPrice().GetAmount(),
+			))
+		}
+		if reply.NextPageToken == nil {
+			break
+		}
+		request.PageToken = reply.NextPageToken
+	}
+	if strings.Join(prices, ",") != "EUR 150,PLN 100,PLN 200,PLN 200,PLN 300" {
+		t.Fatalf("services are not sorted by currency and amount: %v", prices)
+	}
+
+	for _, startKey := range []string{"300", "PLN", "PLN cheap"} {
+		_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+			CompanyId:  proto.String(id),
+			SortBy:     companiespb.ServiceSortField_SERVICE_PRICE,
+			StartKey:   proto.String(startKey),
+			StartValue: services[0].Id,
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	}
+
+	_, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:  proto.String(id),
+		SortBy:     companiespb.ServiceSortField_SERVICE_DURATION,
+		StartKey:   proto.String("long"),
+		StartValue: services[0].Id,
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestPageTokens(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		addCompany(t, client, name)
+	}
+	var names []string
+	request := &companiespb.CompaniesRequest{
+		SortBy:     companiespb.CompanySortField_COMPANY_NAME,
+		Direction:  companiespb.SortDirection_ASCENDING,
+		NamePrefix: proto.String(""),
+		NPerPage:   proto.Int64(2),
+	}
+	var firstToken string
+	for {
+		reply, err := client.FindManyCompanies(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, company := range reply.GetCompanies() {
+			names = append(names, company.GetName())
+		}
+		if reply.NextPageToken == nil {
+			break
+		}
+		if firstToken == "" {
+			firstToken = reply.GetNextPageToken()
+		}
+		request.PageToken = reply.NextPageToken
+	}
+	if strings.Join(names, "") != "ABCDE" {
+		t.Fatalf("unexpected companies: %v", names)
+	}
+
+	// Token is bound to filters and sort order of the request.
+	_, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		SortBy:    companiespb.CompanySortField_COMPANY_NAME,
+		Direction: companiespb.SortDirection_DESCENDING,
+		PageToken: proto.String(firstToken),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		SortBy:     companiespb.CompanySortField_COMPANY_NAME,
+		Direction:  companiespb.SortDirection_ASCENDING,
+		NamePrefix: proto.String("A"),
+		PageToken:  proto.String(firstToken),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	// Page size may change between pages.
+	reply, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		SortBy:     companiespb.CompanySortField_COMPANY_NAME,
+		Direction:  companiespb.SortDirection_ASCENDING,
+		NamePrefix: proto.String(""),
+		PageToken:  proto.String(firstToken),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 3 || reply.GetCompanies()[0].GetName() != "C" {
+		t.Fatalf("unexpected page: %v", reply.GetCompanies())
+	}
+	if reply.NextPageToken != nil {
+		t.Fatal("last page should not have next page token")
+	}
+
+	forged := []byte(firstToken)
+	forged[0] ^= 1
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		PageToken: proto.String(string(forged)),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		PageToken:  proto.String(firstToken),
+		StartValue: proto.String(primitive.NewObjectID().Hex()),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestServicesPageTokens(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	for _, name := range []string{"A", "B", "C"} {
+		addService(t, client, id, name)
+	}
+	var names []string
+	request := &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_PRICE,
+		NPerPage:  proto.Int64(1),
+	}
+	for {
+		reply, err := client.FindManyServices(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, service := range reply.GetServices() {
+			names = append(names, service.GetName())
+		}
+		if reply.NextPageToken == nil {
+			break
+		}
+		request.PageToken = reply.NextPageToken
+	}
+	// All prices are equal, so services are ordered by id.
+	if strings.Join(names, "") != "CBA" {
+		t.Fatalf("unexpected services: %v", names)
+	}
+
+	other := addCompany(t, client, "Other")
+	request.CompanyId = proto.String(other)
+	_, err := client.FindManyServices(ctx, request)
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestTotalCount(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	var ids []string
+	for _, name := range []string{"A", "B", "C"} {
+		ids = append(ids, addCompany(t, client, name))
+	}
+	reply, err := client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		NPerPage:       proto.Int64(2),
+		WithTotalCount: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetTotalCount() != 3 || !reply.GetHasMore() || len(reply.GetCompanies()) != 2 {
+		t.Fatalf("unexpected reply: %v", reply)
+	}
+	// Page which ends exactly at the last company has nothing more.
+	reply, err = client.FindManyCompanies(ctx, &companiespb.CompaniesRequest{
+		NPerPage:  proto.Int64(2),
+		PageToken: reply.NextPageToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.TotalCount != nil || reply.GetHasMore() || reply.NextPageToken != nil {
+		t.Fatalf("unexpected reply: %v", reply)
+	}
+
+	reply, err = client.FindManyCompaniesByIds(ctx, &companiespb.CompaniesByIdsRequest{
+		Ids:            ids[:2],
+		NPerPage:       proto.Int64(2),
+		WithTotalCount: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetTotalCount() != 2 || reply.GetHasMore() {
+		t.Fatalf("unexpected reply: %v", reply)
+	}
+
+	addService(t, client, ids[0], "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:      proto.String(ids[0]),
+		WithTotalCount: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if services.GetTotalCount() != 1 || services.GetHasMore() {
+		t.Fatalf("unexpected reply: %v", services)
+	}
+}
+
+func TestImportCompanies(t *testing.T) {
+	client, store := newClientWithStore(t)
+	ctx := context.Background()
+
+	existing := addCompany(t, client, "Barber")
+	addService(t, client, existing, "Haircut")
+	deleted := addCompany(t, client, "Deleted")
+	_, err := client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(deleted)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.ImportCompanies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := func(name string, price int64) *companiespb.AddServiceRequest {
+		return &companiespb.AddServiceRequest{
+			Name:        proto.String(name),
+			Price:       &companiespb.Money{Amount: proto.Int64(price)},
+			Duration:    proto.Int32(30),
+			Description: proto.String("description"),
+		}
+	}
+	for _, request := range []*companiespb.ImportCompanyRequest{
+		{
+			Company: &companiespb.AddCompanyRequest{
+				Name:            proto.String("Barber"),
+				Type:            proto.String("Barber"),
+				LongDescription: proto.String("imported"),
+				Currency:        proto.String("PLN"),
+			},
+			Services: []*companiespb.AddServiceRequest{
+				service("Haircut", 150),
+				service("Shave", 50),
+			},
+		},
+		{
+			Company: &companiespb.AddCompanyRequest{
+				Name:     proto.String("Dentist"),
+				Currency: proto.String("PLN"),
+			},
+			Services: []*companiespb.AddServiceRequest{service("Checkup", 200)},
+		},
+		{Company: &companiespb.AddCompanyRequest{Name: proto.String("Deleted")}},
+		// Free services are allowed, but their price needs a currency.
+		{
+			Company:  &companiespb.AddCompanyRequest{Name: proto.String("Free")},
+			Services: []*companiespb.AddServiceRequest{service("Consultation", 0)},
+		},
+		{Company: &companiespb.AddCompanyRequest{Name: proto.String("Dentist")}},
+		{},
+	} {
+		if err := stream.Send(request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reply, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetCreated() != 1 || reply.GetUpdated() != 1 || reply.GetRejected() != 4 {
+		t.Fatalf("unexpected summary: %v", reply)
+	}
+	results := reply.GetResults()
+	if results[0].GetStatus() != companiespb.ImportStatus_IMPORT_UPDATED ||
+		results[0].GetId() != existing ||
+		results[1].GetStatus() != companiespb.ImportStatus_IMPORT_CREATED {
+		t.Fatalf("unexpected results: %v", results)
+	}
+	for _, idx := range []int{2, 3, 4, 5} {
+		if results[idx].GetStatus() != companiespb.ImportStatus_IMPORT_REJECTED ||
+			results[idx].GetReason() == "" ||
+			results[idx].Id != nil {
+			t.Fatalf("record %d should be rejected: %v", idx, results[idx])
+		}
+	}
+
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id:                proto.String(existing),
+		ServicesDirection: companiespb.SortDirection_ASCENDING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetType() != "Barber" || company.GetLongDescription() != "imported" ||
+		company.GetVersion() != 2 {
+		t.Fatalf("company was not updated: %v", company)
+	}
+	services := company.GetServices()
+	if len(services) != 2 ||
+		services[0].GetName() != "Haircut" || services[0].GetPrice().GetAmount() != 150 ||
+		services[1].GetName() != "Shave" {
+		t.Fatalf("services were not upserted: %v", services)
+	}
+
+	var eventTypes []string
+	events, err := store.Outbox().FindUnpublished(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range events[len(events)-6:] {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	expected := []string{
+		models.CompanyUpdated,
+		models.ServiceUpdated,
+		models.ServicePriceChanged,
+		models.ServiceCreated,
+		models.CompanyCreated,
+		models.ServiceCreated,
+	}
+	if strings.Join(eventTypes, ",") != strings.Join(expected, ",") {
+		t.Fatalf("unexpected events: %v", eventTypes)
+	}
+}
+
+func TestExportCompanies(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	barber := addCompany(t, client, "Barber")
+	addService(t, client, barber, "Haircut")
+	addService(t, client, barber, "Shave")
+	dentist := addCompany(t, client, "Dentist")
+	deleted := addCompany(t, client, "Deleted")
+	_, err := client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(deleted)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	export := func(request *companiespb.ExportCompaniesRequest) []*companiespb.ExportedCompany {
+		t.Helper()
+		stream, err := client.ExportCompanies(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var companies []*companiespb.ExportedCompany
+		for {
+			company, err := stream.Recv()
+			if err == io.EOF {
+				return companies
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			companies = append(companies, company)
+		}
+	}
+
+	companies := export(&companiespb.ExportCompaniesRequest{})
+	if len(companies) != 2 || companies[0].GetId() != barber || companies[1].GetId() != dentist {
+		t.Fatalf("unexpected companies: %v", companies)
+	}
+	services := companies[0].GetServices()
+	if len(services) != 2 || services[0].GetName() != "Haircut" || services[1].GetName() != "Shave" {
+		t.Fatalf("unexpected services: %v", services)
+	}
+	if companies[0].GetLongDescription() != "long Barber" || len(companies[1].GetServices()) != 0 {
+		t.Fatalf("unexpected companies: %v", companies)
+	}
+
+	companies = export(&companiespb.ExportCompaniesRequest{
+		MaxPrice:      proto.Int64(100),
+		PriceCurrency: proto.String("PLN"),
+	})
+	if len(companies) != 1 || companies[0].GetId() != barber {
+		t.Fatalf("unexpected filtered companies: %v", companies)
+	}
+
+	stream, err := client.ExportCompanies(ctx, &companiespb.ExportCompaniesRequest{
+		MaxDuration: proto.Int32(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.Recv()
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func interval(start int32, end int32) *companiespb.TimeInterval {
+	return &companiespb.TimeInterval{Start: proto.Int32(start), End: proto.Int32(end)}
+}
+
+func TestOpeningHours(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	_, err := client.GetOpeningHours(ctx, &companiespb.OpeningHoursRequest{
+		CompanyId: proto.String(id),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	hours := &companiespb.OpeningHours{
+		TimeZone: proto.String("Europe/Warsaw"),
+		Monday: &companiespb.DayHours{Intervals: []*companiespb.TimeInterval{
+			interval(13*60, 18*60),
+			interval(9*60, 12*60),
+		}},
+		Saturday: &companiespb.DayHours{Intervals: []*companiespb.TimeInterval{
+			interval(10*60, 14*60),
+		}},
+		SpecialDays: []*companiespb.SpecialDay{
+			{Date: proto.String("2026-12-25")},
+			{
+				Date:      proto.String("2026-12-24"),
+				Intervals: []*companiespb.TimeInterval{interval(9*60, 12*60)},
+			},
+		},
+	}
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId:    proto.String(id),
+		OpeningHours: hours,
+		Version:      proto.Int64(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.GetOpeningHours(ctx, &companiespb.OpeningHoursRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	monday := got.GetMonday().GetIntervals()
+	if len(monday) != 2 || monday[0].GetStart() != 9*60 || monday[1].GetStart() != 13*60 {
+		t.Fatalf("monday intervals should be sorted: %v", monday)
+	}
+	if len(got.GetSunday().GetIntervals()) != 0 {
+		t.Fatalf("sunday should be closed: %v", got.GetSunday())
+	}
+	specialDays := got.GetSpecialDays()
+	if len(specialDays) != 2 || specialDays[0].GetDate() != "2026-12-24" ||
+		len(specialDays[1].GetIntervals()) != 0 {
+		t.Fatalf("unexpected special days: %v", specialDays)
+	}
+
+	company, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if company.GetOpeningHours().GetTimeZone() != "Europe/Warsaw" || company.GetVersion() != 2 {
+		t.Fatalf("unexpected company: %v", company)
+	}
+
+	// stale version
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId:    proto.String(id),
+		OpeningHours: hours,
+		Version:      proto.Int64(1),
+	})
+	requireCode(t, err, codes.Aborted)
+
+	// import replaces other fields but keeps opening hours
+	stream, err := client.ImportCompanies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&companiespb.ImportCompanyRequest{Company: &companiespb.AddCompanyRequest{
+		Name:             proto.String("Barber"),
+		Type:             proto.String("Barbershop"),
+		Localisation:     proto.String("Warsaw"),
+		ShortDescription: proto.String("short"),
+		LongDescription:  proto.String("long"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatal(err)
+	}
+	got, err = client.GetOpeningHours(ctx, &companiespb.OpeningHoursRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetSaturday().GetIntervals()) != 1 {
+		t.Fatalf("opening hours were not kept by import: %v", got)
+	}
+
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetOpeningHours(ctx, &companiespb.OpeningHoursRequest{
+		CompanyId: proto.String(id),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId:    proto.String(primitive.NewObjectID().Hex()),
+		OpeningHours: hours,
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestOpeningHoursValidation(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Barber")
+
+	day := func(intervals ...*companiespb.TimeInterval) *companiespb.DayHours {
+		return &companiespb.DayHours{Intervals: intervals}
+	}
+	invalid := map[string]*companiespb.OpeningHours{
+		"missing zone": {},
+		"local zone":   {TimeZone: proto.String("Local")},
+		"unknown zone": {TimeZone: proto.String("Europe/Atlantis")},
+		"overlap": {
+			TimeZone: proto.String("UTC"),
+			Monday:   day(interval(9*60, 13*60), interval(12*60, 18*60)),
+		},
+		"empty interval": {
+			TimeZone: proto.String("UTC"),
+			Monday:   day(interval(9*60, 9*60)),
+		},
+		"past midnight": {
+			TimeZone: proto.String("UTC"),
+			Friday:   day(interval(20*60, 24*60+1)),
+		},
+		"invalid date": {
+			TimeZone:    proto.String("UTC"),
+			SpecialDays: []*companiespb.SpecialDay{{Date: proto.String("24.12.2026")}},
+		},
+		"duplicate date": {
+			TimeZone: proto.String("UTC"),
+			SpecialDays: []*companiespb.SpecialDay{
+				{Date: proto.String("2026-12-24")},
+				{Date: proto.String("2026-12-24")},
+			},
+		},
+	}
+	for name, hours := range invalid {
+		_, err := client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+			CompanyId:    proto.String(id),
+			OpeningHours: hours,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	// adjacent intervals and a whole day are fine
+	_, err := client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId: proto.String(id),
+		OpeningHours: &companiespb.OpeningHours{
+			TimeZone: proto.String("America/New_York"),
+			Monday:   day(interval(0, 12*60), interval(12*60, 24*60)),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClosures(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Barber")
+
+	addClosure := func(startDate string, endDate string, reason string) string {
+		t.Helper()
+		reply, err := client.AddClosure(ctx, &companiespb.AddClosureRequest{
+			CompanyId: proto.String(id),
+			StartDate: proto.String(startDate),
+			EndDate:   proto.String(endDate),
+			Reason:    proto.String(reason),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reply.GetId()
+	}
+	renovation := addClosure("2026-08-01", "2026-08-14", "Renovation")
+	addClosure("2026-12-24", "2026-12-26", "Christmas")
+	addClosure("2026-05-01", "2026-05-01", "Labour Day")
+
+	_, err := client.AddClosure(ctx, &companiespb.AddClosureRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-05-03"),
+		EndDate:   proto.String("2026-05-02"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.AddClosure(ctx, &companiespb.AddClosureRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-5-3"),
+		EndDate:   proto.String("2026-05-04"),
+	})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.AddClosure(ctx, &companiespb.AddClosureRequest{
+		CompanyId: proto.String(primitive.NewObjectID().Hex()),
+		StartDate: proto.String("2026-05-03"),
+		EndDate:   proto.String("2026-05-04"),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	reply, err := client.ListClosures(ctx, &companiespb.ClosuresRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reasons []string
+	for _, closure := range reply.GetClosures() {
+		reasons = append(reasons, closure.GetReason())
+	}
+	if strings.Join(reasons, ",") != "Labour Day,Renovation,Christmas" {
+		t.Fatalf("unexpected closures: %v", reasons)
+	}
+
+	// closures overlapping the range
+	reply, err = client.ListClosures(ctx, &companiespb.ClosuresRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-08-14"),
+		EndDate:   proto.String("2026-12-24"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetClosures()) != 2 || reply.GetClosures()[0].GetId() != renovation {
+		t.Fatalf("unexpected closures in range: %v", reply.GetClosures())
+	}
+
+	_, err = client.DeleteClosure(ctx, &companiespb.DeleteClosureRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(renovation),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteClosure(ctx, &companiespb.DeleteClosureRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(renovation),
+	})
+	requireCode(t, err, codes.NotFound)
+	reply, err = client.ListClosures(ctx, &companiespb.ClosuresRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-08-01"),
+		EndDate:   proto.String("2026-08-31"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetClosures()) != 0 {
+		t.Fatalf("closure was not deleted: %v", reply.GetClosures())
+	}
+}
+
+func TestIsOpenAt(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Barber")
+
+	isOpenAt := func(at string) *companiespb.IsOpenAtReply {
+		t.Helper()
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reply, err := client.IsOpenAt(ctx, &companiespb.IsOpenAtRequest{
+			CompanyId: proto.String(id),
+			Time:      timestamppb.New(parsed),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reply
+	}
+
+	_, err := client.IsOpenAt(ctx, &companiespb.IsOpenAtRequest{
+		CompanyId: proto.String(id),
+		Time:      timestamppb.Now(),
+	})
+	requireCode(t, err, codes.FailedPrecondition)
+
+	weekday := &companiespb.DayHours{Intervals: []*companiespb.TimeInterval{
+		interval(9*60, 17*60),
+	}}
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId: proto.String(id),
+		OpeningHours: &companiespb.OpeningHours{
+			TimeZone:  proto.String("Europe/Warsaw"),
+			Monday:    weekday,
+			Tuesday:   weekday,
+			Wednesday: weekday,
+			Thursday:  weekday,
+			Friday:    weekday,
+			SpecialDays: []*companiespb.SpecialDay{{
+				Date:      proto.String("2026-10-17"),
+				Intervals: []*companiespb.TimeInterval{interval(10*60, 12*60)},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.AddClosure(ctx, &companiespb.AddClosureRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-10-21"),
+		EndDate:   proto.String("2026-10-22"),
+		Reason:    proto.String("Renovation"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		at   string
+		open bool
+	}{
+		// Monday, 9:30 in Warsaw during summer time
+		{"2026-10-19T07:30:00Z", true},
+		// 8:30 in Warsaw
+		{"2026-10-19T06:30:00Z", false},
+		// 17:00 in Warsaw, end is exclusive
+		{"2026-10-19T15:00:00Z", false},
+		// Saturday special day
+		{"2026-10-17T08:30:00Z", true},
+		// Sunday
+		{"2026-10-18T08:30:00Z", false},
+		// Monday after the switch to winter time, 9:30 in Warsaw
+		{"2026-10-26T08:30:00Z", true},
+		{"2026-10-26T07:30:00Z", false},
+	}
+	for _, c := range cases {
+		reply := isOpenAt(c.at)
+		if reply.GetOpen() != c.open || reply.Closure != nil {
+			t.Fatalf("%s: unexpected reply %v", c.at, reply)
+		}
+	}
+
+	reply := isOpenAt("2026-10-21T10:00:00Z")
+	if reply.GetOpen() || reply.GetClosure().GetReason() != "Renovation" {
+		t.Fatalf("company should be closed for renovation: %v", reply)
+	}
+	// 23:30 UTC on the day before is already the first day of closure in Warsaw
+	reply = isOpenAt("2026-10-20T23:30:00+00:00")
+	if reply.GetClosure() == nil {
+		t.Fatalf("closure should be checked in company time zone: %v", reply)
+	}
+}
+
+func TestListAvailableSlots(t *testing.T) {
+	client, store := newClientWithStore(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Barber")
+	companyID, _ := primitive.ObjectIDFromHex(id)
+	addService(t, client, id, "Haircut")
+	services, err := store.Services().FindMany(
+		ctx,
+		companyID,
+		models.ServiceFilter{},
+		models.Sort{},
+		models.Cursor{},
+		1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Haircut takes 30 minutes
+	serviceID := services[0].ID.Hex()
+
+	_, err = client.SetOpeningHours(ctx, &companiespb.SetOpeningHoursRequest{
+		CompanyId: proto.String(id),
+		OpeningHours: &companiespb.OpeningHours{
+			TimeZone: proto.String("Europe/Warsaw"),
+			Monday: &companiespb.DayHours{Intervals: []*companiespb.TimeInterval{
+				interval(9*60, 10*60),
+				interval(12*60, 13*60+15),
+			}},
+			Tuesday: &companiespb.DayHours{Intervals: []*companiespb.TimeInterval{
+				interval(9*60, 10*60),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.AddClosure(ctx, &companiespb.AddClosureRequest{
+		CompanyId: proto.String(id),
+		StartDate: proto.String("2026-10-27"),
+		EndDate:   proto.String("2026-10-27"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listSlots := func(request *companiespb.AvailableSlotsRequest) []string {
+		t.Helper()
+		request.CompanyId = proto.String(id)
+		request.ServiceId = proto.String(serviceID)
+		reply, err := client.ListAvailableSlots(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reply.GetTimeZone() != "Europe/Warsaw" {
+			t.Fatalf("unexpected time zone: %s", reply.GetTimeZone())
+		}
+		location, _ := time.LoadLocation("Europe/Warsaw")
+		var starts []string
+		for _, slot := range reply.GetSlots() {
+			if slot.End.AsTime().Sub(slot.Start.AsTime()) != 30*time.Minute {
+				t.Fatalf("unexpected slot: %v", slot)
+			}
+			starts = append(starts, slot.Start.AsTime().In(location).Format("01-02 15:04"))
+		}
+		return starts
+	}
+
+	// Monday 26th is after the switch to winter time and Tuesday 27th is
+	// closed.
+	starts := listSlots(&companiespb.AvailableSlotsRequest{
+		StartDate: proto.String("2026-10-19"),
+		EndDate:   proto.String("2026-10-27"),
+		Step:      proto.Int32(30),
+	})
+	expected := "10-19 09:00,10-19 09:30,10-19 12:00,10-19 12:30," +
+		"10-20 09:00,10-20 09:30," +
+		"10-26 09:00,10-26 09:30,10-26 12:00,10-26 12:30"
+	if strings.Join(starts, ",") != expected {
+		t.Fatalf("unexpected slots: %v", starts)
+	}
+
+	// default step is 15 minutes
+	starts = listSlots(&companiespb.AvailableSlotsRequest{
+		StartDate: proto.String("2026-10-20"),
+		EndDate:   proto.String("2026-10-20"),
+	})
+	if strings.Join(starts, ",") != "10-20 09:00,10-20 09:15,10-20 09:30" {
+		t.Fatalf("unexpected slots: %v", starts)
+	}
+
+	// busy 12:45-13:00 with 15 minute buffers leaves only 12:00 after
+	// noon, 9:00-10:00 is busy too.
+	busyStart := time.Date(2026, 10, 19, 10, 45, 0, 0, time.UTC)
+	starts = listSlots(&companiespb.AvailableSlotsRequest{
+		StartDate:    proto.String("2026-10-19"),
+		EndDate:      proto.String("2026-10-19"),
+		BufferBefore: proto.Int32(15),
+		BufferAfter:  proto.Int32(15),
+		Busy: []*companiespb.TimeRange{
+			{
+				Start: timestamppb.New(busyStart),
+				End:   timestamppb.New(busyStart.Add(15 * time.Minute)),
+			},
+			{
+				Start: timestamppb.New(time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC)),
+				End:   timestamppb.New(time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)),
+			},
+		},
+	})
+	if strings.Join(starts, ",") != "10-19 12:00" {
+		t.Fatalf("unexpected slots with busy ranges: %v", starts)
+	}
+
+	invalid := []*companiespb.AvailableSlotsRequest{
+		{StartDate: proto.String("2026-10-19")},
+		{StartDate: proto.String("2026-10-20"), EndDate: proto.String("2026-10-19")},
+		{StartDate: proto.String("2026-10-01"), EndDate: proto.String("2026-11-01")},
+		{
+			StartDate: proto.String("2026-10-19"),
+			EndDate:   proto.String("2026-10-19"),
+			Step:      proto.Int32(0),
+		},
+		{
+			StartDate:   proto.String("2026-10-19"),
+			EndDate:     proto.String("2026-10-19"),
+			BufferAfter: proto.Int32(-5),
+		},
+		{
+			StartDate: proto.String("2026-10-19"),
+			EndDate:   proto.String("2026-10-19"),
+			Busy:      []*companiespb.TimeRange{{Start: timestamppb.New(busyStart)}},
+		},
+	}
+	for _, request := range invalid {
+		request.CompanyId = proto.String(id)
+		request.ServiceId = proto.String(serviceID)
+		_, err := client.ListAvailableSlots(ctx, request)
+		requireCode(t, err, codes.InvalidArgument)
+	}
+
+	_, err = client.ListAvailableSlots(ctx, &companiespb.AvailableSlotsRequest{
+		CompanyId: proto.String(id),
+		ServiceId: proto.String(primitive.NewObjectID().Hex()),
+		StartDate: proto.String("2026-10-19"),
+		EndDate:   proto.String("2026-10-19"),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	addServiceWith := func(request *companiespb.AddServiceRequest) string {
+		t.Helper()
+		request.CompanyId = proto.String(id)
+		request.Price = pln(5000)
+		_, err := client.AddService(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Services are listed newest first.
+		services, err := store.Services().FindMany(
+			ctx,
+			companyID,
+			models.ServiceFilter{},
+			models.Sort{},
+			models.Cursor{},
+			1,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return services[0].ID.Hex()
+	}
+	listOptionSlots := func(serviceID string, variant *string, addOns ...string) ([]*companiespb.TimeRange, error) {
+		reply, err := client.ListAvailableSlots(ctx, &companiespb.AvailableSlotsRequest{
+			CompanyId: proto.String(id),
+			ServiceId: proto.String(serviceID),
+			StartDate: proto.String("2026-10-19"),
+			EndDate:   proto.String("2026-10-19"),
+			Variant:   variant,
+			AddOns:    addOns,
+		})
+		return reply.GetSlots(), err
+	}
+	// Service without duration has no slots, not slots of zero length.
+	consultation := addServiceWith(&companiespb.AddServiceRequest{
+		Name: proto.String("Consultation"),
+	})
+	_, err = listOptionSlots(consultation, nil)
+	requireCode(t, err, codes.FailedPrecondition)
+
+	coloring := addServiceWith(&companiespb.AddServiceRequest{
+		Name:     proto.String("Coloring"),
+		Duration: proto.Int32(30),
+		Variants: []*companiespb.ServiceVariant{{
+			Name:     proto.String("Long"),
+			Price:    pln(8000),
+			Duration: proto.Int32(60),
+		}},
+		AddOns: []*companiespb.ServiceAddOn{{
+			Name:     proto.String("Wash"),
+			Price:    pln(1000),
+			Duration: proto.Int32(15),
+		}},
+	})
+	// Long coloring with wash takes 75 minutes, which fits only after noon.
+	slots, err := listOptionSlots(coloring, proto.String("Long"), "Wash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 1 || slots[0].End.AsTime().Sub(slots[0].Start.AsTime()) != 75*time.Minute {
+		t.Fatalf("unexpected slots of variant with add-on: %v", slots)
+	}
+	_, err = listOptionSlots(coloring, proto.String("Short"))
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = listOptionSlots(coloring, nil, "Massage")
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = listOptionSlots(coloring, nil, "Wash", "Wash")
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestStaff(t *testing.T) {
+	client, store := newClientWithStore(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Clinic")
+	addService(t, client, id, "Checkup")
+	addService(t, client, id, "Surgery")
+
+	addStaff := func(name string, role string) string {
+		t.Helper()
+		reply, err := client.AddStaff(ctx, &companiespb.AddStaffRequest{
+			CompanyId: proto.String(id),
+			Name:      proto.String(name),
+			Role:      proto.String(role),
+			Bio:       proto.String("bio " + name),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reply.GetId()
+	}
+	anna := addStaff("Anna", "Doctor")
+	jan := addStaff("Jan", "Surgeon")
+
+	_, err := client.AddStaff(ctx, &companiespb.AddStaffRequest{CompanyId: proto.String(id)})
+	requireCode(t, err, codes.InvalidArgument)
+	_, err = client.AddStaff(ctx, &companiespb.AddStaffRequest{
+		CompanyId: proto.String(primitive.NewObjectID().Hex()),
+		Name:      proto.String("Ewa"),
+	})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = client.UpdateStaff(ctx, &companiespb.UpdateStaffRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(jan),
+		Active:    proto.Bool(false),
+		Version:   proto.Int64(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UpdateStaff(ctx, &companiespb.UpdateStaffRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(jan),
+		Role:      proto.String("Doctor"),
+		Version:   proto.Int64(1),
+	})
+	requireCode(t, err, codes.Aborted)
+
+	staffReply, err := client.FindManyStaff(ctx, &companiespb.StaffRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	staff := staffReply.GetStaff()
+	if len(staff) != 2 || staff[0].GetName() != "Anna" || !staff[0].GetActive() ||
+		staff[1].GetActive() || staff[1].GetRole() != "Surgeon" {
+		t.Fatalf("unexpected staff: %v", staff)
+	}
+	staffReply, err = client.FindManyStaff(ctx, &companiespb.StaffRequest{
+		CompanyId:  proto.String(id),
+		ActiveOnly: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(staffReply.GetStaff()) != 1 || staffReply.GetStaff()[0].GetId() != anna {
+		t.Fatalf("unexpected active staff: %v", staffReply.GetStaff())
+	}
+
+	servicesReply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_CREATED_AT,
+		Direction: companiespb.SortDirection_ASCENDING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkup := servicesReply.GetServices()[0]
+	surgery := servicesReply.GetServices()[1]
+	assign := func(service *companiespb.Service, staffIDs ...string) error {
+		_, err := client.AssignStaff(ctx, &companiespb.AssignStaffRequest{
+			CompanyId: proto.String(id),
+			ServiceId: service.Id,
+			StaffIds:  staffIDs,
+		})
+		return err
+	}
+	if err := assign(checkup, anna, jan, anna); err != nil {
+		t.Fatal(err)
+	}
+	if err := assign(surgery, jan); err != nil {
+		t.Fatal(err)
+	}
+	err = assign(surgery, primitive.NewObjectID().Hex())
+	requireCode(t, err, codes.InvalidArgument)
+
+	servicesOf := func(staffID string) []string {
+		t.Helper()
+		reply, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+			CompanyId:      proto.String(id),
+			StaffId:        proto.String(staffID),
+			SortBy:         companiespb.ServiceSortField_SERVICE_CREATED_AT,
+			Direction:      companiespb.SortDirection_ASCENDING,
+			WithTotalCount: proto.Bool(true),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, service := range reply.GetServices() {
+			names = append(names, service.GetName())
+		}
+		if reply.GetTotalCount() != int64(len(names)) {
+			t.Fatalf("unexpected total count %d of %v", reply.GetTotalCount(), names)
+		}
+		return names
+	}
+	if names := servicesOf(anna); strings.Join(names, ",") != "Checkup" {
+		t.Fatalf("unexpected services of Anna: %v", names)
+	}
+	if names := servicesOf(jan); strings.Join(names, ",") != "Checkup,Surgery" {
+		t.Fatalf("unexpected services of Jan: %v", names)
+	}
+
+	_, err = client.DeleteStaff(ctx, &companiespb.DeleteStaffRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(jan),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := servicesOf(jan); len(names) != 0 {
+		t.Fatalf("deleted staff member still performs services: %v", names)
+	}
+	events, err := store.Outbox().FindUnpublished(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var updated []string
+	for _, event := range events[len(events)-3:] {
+		if event.Type == models.ServiceUpdated {
+			if !strings.Contains(event.Payload, "staff_ids") {
+				t.Fatalf("unexpected payload of cascaded update: %s", event.Payload)
+			}
+			updated = append(updated, event.ServiceID.Hex())
+		}
+	}
+	if strings.Join(updated, ",") != surgery.GetId()+","+checkup.GetId() {
+		t.Fatalf("services of deleted staff member were not recorded: %v", events)
+	}
+	servicesReply, err = client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+		SortBy:    companiespb.ServiceSortField_SERVICE_CREATED_AT,
+		Direction: companiespb.SortDirection_ASCENDING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	staffIDs := servicesReply.GetServices()[0].GetStaffIds()
+	if len(staffIDs) != 1 || staffIDs[0] != anna {
+		t.Fatalf("unexpected staff of checkup: %v", staffIDs)
+	}
+	_, err = client.DeleteStaff(ctx, &companiespb.DeleteStaffRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(jan),
+	})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestCategories(t *testing.T) {
+	client, store := newClientWithStore(t)
+	ctx := context.Background()
+	id := addCompany(t, client, "Clinic")
+
+	addCategory := func(name string, position int32) string {
+		t.Helper()
+		reply, err := client.AddCategory(ctx, &companiespb.AddCategoryRequest{
+			CompanyId: proto.String(id),
+			Name:      proto.String(name),
+			Position:  proto.Int32(position),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reply.GetId()
+	}
+	surgery := addCategory("Surgery", 2)
+	dental := addCategory("Dental", 1)
+
+	_, err := client.AddCategory(ctx, &companiespb.AddCategoryRequest{
+		CompanyId: proto.String(id),
+		Name:      proto.String("Dental"),
+	})
+	requireCode(t, err, codes.AlreadyExists)
+	_, err = client.AddCategory(ctx, &companiespb.AddCategoryRequest{CompanyId: proto.String(id)})
+	requireCode(t, err, codes.InvalidArgument)
+
+	addServiceIn := func(name string, categoryID *string) error {
+		_, err := client.AddService(ctx, &companiespb.AddServiceRequest{
+			CompanyId:  proto.String(id),
+			Name:       proto.String(name),
+			Price:      pln(100),
+			Duration:   proto.Int32(30),
+			CategoryId: categoryID,
+		})
+		return err
+	}
+	for _, service := range []struct {
+		name       string
+		categoryID *string
+	}{
+		{"Filling", proto.String(dental)},
+		{"Whitening", proto.String(dental)},
+		{"Extraction", proto.String(surgery)},
+		{"Checkup", nil},
+	} {
+		if err := addServiceIn(service.name, service.categoryID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = addServiceIn("Massage", proto.String(primitive.NewObjectID().Hex()))
+	requireCode(t, err, codes.InvalidArgument)
+
+	reply, err := client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id:                proto.String(id),
+		ServicesSortBy:    companiespb.ServiceSortField_SERVICE_CREATED_AT,
+		ServicesDirection: companiespb.SortDirection_ASCENDING,
+		ServicesNPerPage:  proto.Int64(1),
+		WithServiceGroups: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var groups []string
+	for _, group := range reply.GetServiceGroups() {
+		groups = append(groups, fmt.Sprintf(
+			"%s:%s:%d",
+			group.GetCategory().GetName(),
+			group.GetServices()[0].GetName(),
+			group.GetTotalCount(),
+		))
+	}
+	if strings.Join(groups, ",") != "Dental:Filling:2,Surgery:Extraction:1,:Checkup:1" {
+		t.Fatalf("unexpected service groups: %v", groups)
+	}
+	dentalGroup := reply.GetServiceGroups()[0]
+	if dentalGroup.NextPageToken == nil || reply.GetServiceGroups()[1].NextPageToken != nil {
+		t.Fatalf("unexpected page tokens of groups: %v", reply.GetServiceGroups())
+	}
+	page, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:  proto.String(id),
+		CategoryId: proto.String(dental),
+		SortBy:     companiespb.ServiceSortField_SERVICE_CREATED_AT,
+		Direction:  companiespb.SortDirection_ASCENDING,
+		PageToken:  dentalGroup.NextPageToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.GetServices()) != 1 || page.GetServices()[0].GetName() != "Whitening" ||
+		page.GetServices()[0].GetCategoryId() != dental {
+		t.Fatalf("unexpected next page of group: %v", page.GetServices())
+	}
+
+	checkup := reply.GetServiceGroups()[2].GetServices()[0]
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId:  proto.String(id),
+		Id:         checkup.Id,
+		CategoryId: proto.String(dental),
+		Version:    checkup.Version,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The group without category is returned even when it is empty.
+	reply, err = client.FindOneCompany(ctx, &companiespb.CompanyRequest{
+		Id:                proto.String(id),
+		WithServiceGroups: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetServiceGroups()) != 3 {
+		t.Fatalf("expected 3 service groups, got %v", reply.GetServiceGroups())
+	}
+	emptyGroup := reply.GetServiceGroups()[2]
+	if emptyGroup.Category != nil || len(emptyGroup.GetServices()) != 0 ||
+		emptyGroup.GetTotalCount() != 0 || emptyGroup.NextPageToken != nil {
+		t.Fatalf("unexpected group without category: %v", emptyGroup)
+	}
+	_, err = client.UpdateCategory(ctx, &companiespb.UpdateCategoryRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(surgery),
+		Name:      proto.String("Dental"),
+	})
+	requireCode(t, err, codes.AlreadyExists)
+	_, err = client.UpdateCategory(ctx, &companiespb.UpdateCategoryRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(surgery),
+		Position:  proto.Int32(0),
+		Version:   proto.Int64(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	categories, err := client.FindManyCategories(ctx, &companiespb.CategoriesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(categories.GetCategories()) != 2 ||
+		categories.GetCategories()[0].GetName() != "Surgery" ||
+		categories.GetCategories()[0].GetVersion() != 2 {
+		t.Fatalf("unexpected categories: %v", categories.GetCategories())
+	}
+
+	_, err = client.DeleteCategory(ctx, &companiespb.DeleteCategoryRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(dental),
+		Version:   proto.Int64(2),
+	})
+	requireCode(t, err, codes.Aborted)
+	_, err = client.DeleteCategory(ctx, &companiespb.DeleteCategoryRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(dental),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncategorized, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId:      proto.String(id),
+		CategoryId:     proto.String(""),
+		WithTotalCount: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uncategorized.GetTotalCount() != 3 {
+		t.Fatalf("services of deleted category were not uncategorized: %v", uncategorized.GetServices())
+	}
+	events, err := store.Outbox().FindUnpublished(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var updated int
+	for _, event := range events[len(events)-4:] {
+		if event.Type == models.ServiceUpdated && strings.Contains(event.Payload, "category_id") {
+			updated++
+		}
+	}
+	if events[len(events)-4].Type != models.CategoryDeleted || updated != 3 {
+		t.Fatalf("services of deleted category were not recorded: %v", events)
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	client := newClient(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-actor", "admin")
+
+	id := addCompany(t, client, "Barber")
+	otherID := addCompany(t, client, "Salon")
+	addService(t, client, id, "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceID := services.GetServices()[0].GetId()
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        proto.String(serviceID),
+		Price:     pln(150),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(otherID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := client.ListAuditEvents(ctx, &companiespb.AuditEventsRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := reply.GetEvents()
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	update := events[0]
+	if update.GetRpc() != "UpdateService" ||
+		update.GetActor() != "admin" ||
+		update.GetServiceId() != serviceID {
+		t.Fatalf("unexpected event: %v", update)
+	}
+	changes := update.GetChanges()
+	if len(changes) != 1 ||
+		changes[0].GetField() != "price" ||
+		changes[0].GetBefore() != "1.00 PLN" ||
+		changes[0].GetAfter() != "1.50 PLN" {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	if events[1].GetRpc() != "AddService" || events[2].GetRpc() != "AddCompany" {
+		t.Fatalf("unexpected events order: %v", events)
+	}
+	if events[2].GetActor() != "anonymous" {
+		t.Fatalf("expected anonymous actor: %v", events[2])
+	}
+
+	reply, err = client.ListAuditEvents(ctx, &companiespb.AuditEventsRequest{
+		CompanyId: proto.String(otherID),
+		NPerPage:  proto.Int64(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetEvents()[0].GetRpc() != "DeleteCompany" || !reply.GetHasMore() {
+		t.Fatalf("unexpected page: %v", reply)
+	}
+	reply, err = client.ListAuditEvents(ctx, &companiespb.AuditEventsRequest{
+		CompanyId: proto.String(otherID),
+		NPerPage:  proto.Int64(1),
+		PageToken: reply.NextPageToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetEvents()[0].GetRpc() != "AddCompany" ||
+		reply.GetHasMore() || reply.NextPageToken != nil {
+		t.Fatalf("unexpected last page: %v", reply)
+	}
+
+	reply, err = client.ListAuditEvents(ctx, &companiespb.AuditEventsRequest{
+		From: timestamppb.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetEvents()) != 0 || reply.GetHasMore() {
+		t.Fatalf("expected empty page, got %v", reply)
+	}
+}
+
+func TestOutboxEvents(t *testing.T) {
+	client, store := newClientWithStore(t)
+	ctx := context.Background()
+
+	id := addCompany(t, client, "Barber")
+	addService(t, client, id, "Haircut")
+	services, err := client.FindManyServices(ctx, &companiespb.ServicesRequest{
+		CompanyId: proto.String(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := services.GetServices()[0]
+	_, err = client.UpdateService(ctx, &companiespb.UpdateServiceRequest{
+		CompanyId: proto.String(id),
+		Id:        service.Id,
+		Price:     pln(150),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// failed mutation does not leave events behind
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{Name: proto.String("Barber")})
+	requireCode(t, err, codes.AlreadyExists)
+	_, err = client.DeleteCompany(ctx, &companiespb.DeleteCompanyRequest{Id: proto.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := &events.LocalPublisher{}
+	relay := &events.Relay{Outbox: store.Outbox(), Publisher: publisher, BatchSize: 10}
+	published, err := relay.RelayOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		models.CompanyCreated,
+		models.ServiceCreated,
+		models.ServiceUpdated,
+		models.ServicePriceChanged,
+		models.CompanyDeleted,
+	}
+	if published != len(expected) {
+		t.Fatalf("expected %d published events, got %d", len(expected), published)
+	}
+	for idx, event := range publisher.Events() {
+		if event.Type != expected[idx] || event.CompanyID != id {
+			t.Fatalf("unexpected event %d: %+v", idx, event)
+		}
+	}
+	priceChanged := publisher.Events()[3]
+	if priceChanged.ServiceID != service.GetId() {
+		t.Fatalf("unexpected service of event: %+v", priceChanged)
+	}
+
+	published, err = relay.RelayOnce(ctx)
+	if err != nil || published != 0 {
+		t.Fatalf("events should be published once: %d, %v", published, err)
+	}
+}
+
+func TestSearchCompanies(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	addCompany(t, client, "Barber")
+	_, err := client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name:             proto.String("Hair studio"),
+		Type:             proto.String("Salon"),
+		ShortDescription: proto.String("Best barber in town"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name:            proto.String("Dentist"),
+		LongDescription: proto.String("We also have a barber next door"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := client.SearchCompanies(ctx, &companiespb.SearchCompaniesRequest{
+		Query:    proto.String("barber"),
+		NPerPage: proto.Int64(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	companies := reply.GetCompanies()
+	if len(companies) != 2 ||
+		companies[0].GetName() != "Barber" ||
+		companies[1].GetName() != "Hair studio" {
+		t.Fatalf("unexpected first page: %v", companies)
+	}
+	if companies[0].GetScore() <= companies[1].GetScore() {
+		t.Fatalf("companies are not ordered by relevance: %v", companies)
+	}
+	last := companies[len(companies)-1]
+	reply, err = client.SearchCompanies(ctx, &companiespb.SearchCompaniesRequest{
+		Query:      proto.String("barber"),
+		StartScore: last.Score,
+		StartValue: last.Id,
+		NPerPage:   proto.Int64(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 1 || reply.GetCompanies()[0].GetName() != "Dentist" {
+		t.Fatalf("unexpected second page: %v", reply.GetCompanies())
+	}
+
+	reply, err = client.SearchCompanies(ctx, &companiespb.SearchCompaniesRequest{
+		Query: proto.String("florist"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 0 {
+		t.Fatalf("expected no companies, got %v", reply.GetCompanies())
+	}
+
+	_, err = client.SearchCompanies(ctx, &companiespb.SearchCompaniesRequest{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	for _, nPerPage := range []int64{0, -1} {
+		_, err = client.SearchCompanies(ctx, &companiespb.SearchCompaniesRequest{
+			Query:    proto.String("barber"),
+			NPerPage: proto.Int64(nPerPage),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	}
+}
+
+func TestFindCompaniesNear(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	locations := map[string]*companiespb.GeoPoint{
+		"Old Town": {Latitude: proto.Float64(52.2497), Longitude: proto.Float64(21.0122)},
+		"Centre":   {Latitude: proto.Float64(52.2318), Longitude: proto.Float64(21.0060)},
+		"Krakow":   {Latitude: proto.Float64(50.0614), Longitude: proto.Float64(19.9366)},
+	}
+	ids := make(map[string]string)
+	for name, location := range locations {
+		reply, err := client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+			Name:     proto.String(name),
+			Location: location,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[name] = reply.GetId()
+	}
+	addCompany(t, client, "Nowhere")
+
+	request := &companiespb.CompaniesNearRequest{
+		Latitude:  proto.Float64(52.2320),
+		Longitude: proto.Float64(21.0061),
+		Radius:    proto.Float64(5000),
+		NPerPage:  proto.Int64(1),
+	}
+	reply, err := client.FindCompaniesNear(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nearest := reply.GetCompanies()[0]
+	if nearest.GetId() != ids["Centre"] || nearest.GetDistance() > 100 {
+		t.Fatalf("unexpected nearest company: %v", nearest)
+	}
+	if nearest.GetLocation().GetLatitude() != 52.2318 {
+		t.Fatalf("location was not returned: %v", nearest)
+	}
+	request.StartDistance = nearest.Distance
+	request.StartValue = nearest.Id
+	reply, err = client.FindCompaniesNear(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := reply.GetCompanies()[0]
+	if second.GetId() != ids["Old Town"] || second.GetDistance() < 1500 || second.GetDistance() > 2500 {
+		t.Fatalf("unexpected second company: %v", second)
+	}
+	if reply.GetHasMore() {
+		t.Fatal("second company should be the last one")
+	}
+	request.StartDistance = second.Distance
+	request.StartValue = second.Id
+	reply, err = client.FindCompaniesNear(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 0 {
+		t.Fatalf("expected no companies, got %v", reply.GetCompanies())
+	}
+
+	// moving company to Warsaw makes it nearby
+	_, err = client.UpdateCompany(ctx, &companiespb.UpdateCompanyRequest{
+		Id:       proto.String(ids["Krakow"]),
+		Location: locations["Old Town"],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.StartValue = nil
+	request.NPerPage = nil
+	reply, err = client.FindCompaniesNear(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.GetCompanies()) != 3 {
+		t.Fatalf("expected 3 nearby companies, got %v", reply.GetCompanies())
+	}
+
+	invalid := []*companiespb.CompaniesNearRequest{
+		{
+			Latitude:  proto.Float64(91),
+			Longitude: proto.Float64(0),
+			Radius:    proto.Float64(1000),
+		},
+		{
+			Latitude:  proto.Float64(math.NaN()),
+			Longitude: proto.Float64(0),
+			Radius:    proto.Float64(1000),
+		},
+		{
+			Latitude:  proto.Float64(0),
+			Longitude: proto.Float64(0),
+			Radius:    proto.Float64(math.NaN()),
+		},
+		{
+			Latitude:  proto.Float64(0),
+			Longitude: proto.Float64(math.Inf(1)),
+			Radius:    proto.Float64(1000),
+		},
+		{
+			Latitude:  proto.Float64(0),
+			Longitude: proto.Float64(0),
+			Radius:    proto.Float64(1000),
+			NPerPage:  proto.Int64(0),
+		},
+		{
+			Latitude:  proto.Float64(0),
+			Longitude: proto.Float64(0),
+			Radius:    proto.Float64(1000),
+			NPerPage:  proto.Int64(-1),
+		},
+	}
+	for _, request := range invalid {
+		_, err = client.FindCompaniesNear(ctx, request)
+		requireCode(t, err, codes.InvalidArgument)
+	}
+
+	_, err = client.AddCompany(ctx, &companiespb.AddCompanyRequest{
+		Name:     proto.String("Half location"),
+		Location: &companiespb.GeoPoint{Latitude: proto.Float64(52)},
+	})
+	requireCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,64 @@
+package companiespb
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+func newExportedCompany(
+	companyModel *models.Company,
+	services []models.Service,
+) *ExportedCompany {
+	companyID := companyModel.ID.Hex()
+	companyProto := &ExportedCompany{
+		Id:               &companyID,
+		Name:             &companyModel.Name,
+		Type:             &companyModel.Type,
+		Localisation:     &companyModel.Localisation,
+		ShortDescription: &companyModel.ShortDescription,
+		LongDescription:  &companyModel.LongDescription,
+		Location:         newGeoPointProto(companyModel.Location),
+		Version:          &companyModel.Version,
+		Currency:         &companyModel.Currency,
+	}
+	for idx := range services {
+		companyProto.Services = append(companyProto.Services, newServiceProto(&services[idx]))
+	}
+	return companyProto
+}
+
+// ExportCompanies streams all companies matching filters of the request
+// straight from the storage.
+func (s *Server) ExportCompanies(
+	request *ExportCompaniesRequest,
+	stream Api_ExportCompaniesServer,
+) error {
+	filter, err := newCompanyFilter(&CompaniesRequest{
+		Types:         request.Types,
+		NamePrefix:    request.NamePrefix,
+		PriceCurrency: request.PriceCurrency,
+		MinPrice:      request.MinPrice,
+		MaxPrice:      request.MaxPrice,
+		MaxDuration:   request.MaxDuration,
+	})
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	err = s.Companies.Export(
+		ctx,
+		filter,
+		func(company *models.Company, services []models.Service) error {
+			return stream.Send(newExportedCompany(company, services))
+		},
+	)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package companiespb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// intervalsLimit is the maximum number of intervals of a single day.
+const intervalsLimit = 10
+
+// specialDaysLimit is the maximum number of special days of a company.
+const specialDaysLimit = 366
+
+// loadTimeZone accepts only IANA time zone names, Local depends on the
+// machine running the server and is rejected.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, status.Error(codes.InvalidArgument, "time_zone should be set to IANA time zone name")
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown time zone %q", name)
+	}
+	return location, nil
+}
+
+func verifyDate(date string) error {
+	_, err := time.Parse(models.DateLayout, date)
+	if err != nil {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Date %q should be in YYYY-MM-DD format",
+			date,
+		)
+	}
+	return nil
+}
+
+// verifyDateRange checks that both dates are set and the range has at most
+// maxDays days.
+func verifyDateRange(startDate *string, endDate *string, maxDays int) error {
+	if startDate == nil || endDate == nil {
+		return status.Error(
+			codes.InvalidArgument,
+			"start_date and end_date should be set",
+		)
+	}
+	if err := verifyDate(*startDate); err != nil {
+		return err
+	}
+	if err := verifyDate(*endDate); err != nil {
+		return err
+	}
+	first, _ := time.Parse(models.DateLayout, *startDate)
+	last, _ := time.Parse(models.DateLayout, *endDate)
+	if first.After(last) {
+		return status.Error(
+			codes.InvalidArgument,
+			"start_date should not be after end_date",
+		)
+	}
+	if last.Sub(first) >= time.Duration(maxDays)*24*time.Hour {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Range should have at most %d days",
+			maxDays,
+		)
+	}
+	return nil
+}
+
+// newIntervals validates intervals of a single day and returns them
+// sorted by start.
+func newIntervals(intervalsProto []*TimeInterval) ([]models.Interval, error) {
+	if len(intervalsProto) > intervalsLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d intervals per day",
+			intervalsLimit,
+		)
+	}
+	intervals := []models.Interval{}
+	for _, intervalProto := range intervalsProto {
+		if intervalProto.Start == nil || intervalProto.End == nil {
+			return nil, status.Error(codes.InvalidArgument, "start and end should be set")
+		}
+		err := verifyInteger(intervalProto.Start, -1, models.MinutesPerDay-1)
+		if err != nil {
+			return nil, err
+		}
+		err = verifyInteger(intervalProto.End, 0, models.MinutesPerDay)
+		if err != nil {
+			return nil, err
+		}
+		if intervalProto.GetStart() >= intervalProto.GetEnd() {
+			return nil, status.Error(codes.InvalidArgument, "start should be before end")
+		}
+		intervals = append(intervals, models.Interval{
+			Start: intervalProto.GetStart(),
+			End:   intervalProto.GetEnd(),
+		})
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start < intervals[j].Start
+	})
+	for idx := 1; idx < len(intervals); idx++ {
+		if intervals[idx].Start < intervals[idx-1].End {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Intervals %s and %s overlap",
+				intervals[idx-1],
+				intervals[idx],
+			)
+		}
+	}
+	return intervals, nil
+}
+
+func newOpeningHours(hoursProto *OpeningHours) (*models.OpeningHours, error) {
+	if hoursProto == nil {
+		return nil, nil
+	}
+	_, err := loadTimeZone(hoursProto.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	hours := &models.OpeningHours{TimeZone: hoursProto.GetTimeZone()}
+	weekly := [7]*DayHours{
+		time.Sunday:    hoursProto.Sunday,
+		time.Monday:    hoursProto.Monday,
+		time.Tuesday:   hoursProto.Tuesday,
+		time.Wednesday: hoursProto.Wednesday,
+		time.Thursday:  hoursProto.Thursday,
+		time.Friday:    hoursProto.Friday,
+		time.Saturday:  hoursProto.Saturday,
+	}
+	for day, dayProto := range weekly {
+		hours.Weekly[day], err = newIntervals(dayProto.GetIntervals())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(hoursProto.GetSpecialDays()) > specialDaysLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d special days",
+			specialDaysLimit,
+		)
+	}
+	dates := make(map[string]bool)
+	for _, specialDayProto := range hoursProto.GetSpecialDays() {
+		date := specialDayProto.GetDate()
+		err := verifyDate(date)
+		if err != nil {
+			return nil, err
+		}
+		if dates[date] {
+			return nil, status.Errorf(codes.InvalidArgument, "Date %s is listed twice", date)
+		}
+		dates[date] = true
+		intervals, err := newIntervals(specialDayProto.GetIntervals())
+		if err != nil {
+			return nil, err
+		}
+		hours.SpecialDays = append(hours.SpecialDays, models.SpecialDay{
+			Date:      date,
+			Intervals: intervals,
+		})
+	}
+	sort.Slice(hours.SpecialDays, func(i, j int) bool {
+		return hours.SpecialDays[i].Date < hours.SpecialDays[j].Date
+	})
+	return hours, nil
+}
+
+func newDayHoursProto(intervals []models.Interval) *DayHours {
+	dayProto := &DayHours{}
+	for idx := range intervals {
+		dayProto.Intervals = append(dayProto.Intervals, &TimeInterval{
+			Start: &intervals[idx].Start,
+			End:   &intervals[idx].End,
+		})
+	}
+	return dayProto
+}
+
+func newOpeningHoursProto(hours *models.OpeningHours) *OpeningHours {
+	if hours == nil {
+		return nil
+	}
+	hoursProto := &OpeningHours{
+		TimeZone:  &hours.TimeZone,
+		Monday:    newDayHoursProto(hours.Weekly[time.Monday]),
+		Tuesday:   newDayHoursProto(hours.Weekly[time.Tuesday]),
+		Wednesday: newDayHoursProto(hours.Weekly[time.Wednesday]),
+		Thursday:  newDayHoursProto(hours.Weekly[time.Thursday]),
+		Friday:    newDayHoursProto(hours.Weekly[time.Friday]),
+		Saturday:  newDayHoursProto(hours.Weekly[time.Saturday]),
+		Sunday:    newDayHoursProto(hours.Weekly[time.Sunday]),
+	}
+	for idx := range hours.SpecialDays {
+		specialDay := &hours.SpecialDays[idx]
+		hoursProto.SpecialDays = append(hoursProto.SpecialDays, &SpecialDay{
+			Date:      &specialDay.Date,
+			Intervals: newDayHoursProto(specialDay.Intervals).Intervals,
+		})
+	}
+	return hoursProto
+}
+
+func (s *Server) SetOpeningHours(
+	ctx context.Context,
+	request *SetOpeningHoursRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hours, err := newOpeningHours(request.OpeningHours)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Companies.FindOne(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		err = s.Companies.SetOpeningHours(ctx, companyID, hours, request.Version)
+		if err != nil {
+			return err
+		}
+		after := *before
+		after.OpeningHours = hours
+		return s.record(ctx, mutation{
+			rpc:       "SetOpeningHours",
+			companyID: companyID,
+			changes:   models.CompanyDiff(before, &after),
+			events:    []string{models.OpeningHoursChanged},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Company was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetOpeningHours returns NotFound when company has no opening hours set.
+func (s *Server) GetOpeningHours(
+	ctx context.Context,
+	request *OpeningHoursRequest,
+) (*OpeningHours, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	companyModel, err := s.Companies.FindOne(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if companyModel.OpeningHours == nil {
+		return nil, status.Error(
+			codes.NotFound,
+			"Company has no opening hours set",
+		)
+	}
+	return newOpeningHoursProto(companyModel.OpeningHours), nil
+}
@@ -0,0 +1,264 @@
+package companiespb
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// importBatchSize is the number of valid records written in a single
+// transaction.
+const importBatchSize = 100
+
+// importServicesLimit is the maximum number of services of a single
+// imported company.
+const importServicesLimit = 100
+
+// newCompanyImport validates the record with the same rules as AddCompany
+// and AddService.
+func newCompanyImport(request *ImportCompanyRequest) (models.CompanyImport, error) {
+	var companyImport models.CompanyImport
+	companyRequest := request.GetCompany()
+	if companyRequest == nil {
+		return companyImport, status.Error(codes.InvalidArgument, "company should be set")
+	}
+	err := verifyAddCompany(companyRequest)
+	if err != nil {
+		return companyImport, err
+	}
+	if len(request.GetServices()) > importServicesLimit {
+		return companyImport, status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d services",
+			importServicesLimit,
+		)
+	}
+	companyImport.Company = models.Company{
+		Name:             companyRequest.GetName(),
+		Type:             companyRequest.GetType(),
+		Localisation:     companyRequest.GetLocalisation(),
+		ShortDescription: companyRequest.GetShortDescription(),
+		LongDescription:  companyRequest.GetLongDescription(),
+		Location:         newGeoPoint(companyRequest.Location),
+		Currency:         companyRequest.GetCurrency(),
+	}
+	names := make(map[string]bool)
+	for _, serviceRequest := range request.GetServices() {
+		err := verifyAddService(serviceRequest)
+		if err != nil {
+			return companyImport, err
+		}
+		if names[serviceRequest.GetName()] {
+			return companyImport, status.Errorf(
+				codes.InvalidArgument,
+				"Service %q is listed twice",
+				serviceRequest.GetName(),
+			)
+		}
+		names[serviceRequest.GetName()] = true
+		service, err := newImportedService(serviceRequest, companyRequest.GetCurrency())
+		if err != nil {
+			return companyImport, status.Error(codes.InvalidArgument, err.Error())
+		}
+		companyImport.Services = append(companyImport.Services, service)
+	}
+	return companyImport, nil
+}
+
+// newImportedService converts the service priced by default in currency of
+// its company.
+func newImportedService(
+	request *AddServiceRequest,
+	currency string,
+) (models.Service, error) {
+	service := models.Service{
+		Name:        request.GetName(),
+		Duration:    request.GetDuration(),
+		Description: request.GetDescription(),
+	}
+	var err error
+	service.Price, err = newMoney(request.Price, currency)
+	if err != nil {
+		return service, err
+	}
+	service.Variants, err = newVariants(request.Variants, service.Price.Currency)
+	if err != nil {
+		return service, err
+	}
+	service.AddOns, err = newAddOns(request.AddOns, service.Price.Currency)
+	if err != nil {
+		return service, err
+	}
+	return service, checkCurrencies(&service)
+}
+
+// importBatch is a batch of valid records waiting to be written together
+// with their results in the reply.
+type importBatch struct {
+	imports []models.CompanyImport
+	results []*ImportResult
+}
+
+// ImportCompanies writes valid records in batches, each in its own
+// transaction, so batches written before a failure stay written.
+func (s *Server) ImportCompanies(stream Api_ImportCompaniesServer) error {
+	ctx := stream.Context()
+	reply := &ImportCompaniesReply{}
+	// seen maps names to indexes of records, the same company should not
+	// be imported twice.
+	seen := make(map[string]int64)
+	var batch importBatch
+	for index := int64(0); ; index++ {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		result := &ImportResult{Index: proto.Int64(index)}
+		if request.Company != nil {
+			result.Name = request.Company.Name
+		}
+		reply.Results = append(reply.Results, result)
+		companyImport, err := newCompanyImport(request)
+		if err == nil {
+			if previous, ok := seen[companyImport.Company.Name]; ok {
+				err = fmt.Errorf("Company was already imported by record %d", previous)
+			}
+		}
+		if err != nil {
+			reject(result, err)
+			continue
+		}
+		seen[companyImport.Company.Name] = index
+		batch.imports = append(batch.imports, companyImport)
+		batch.results = append(batch.results, result)
+		if len(batch.imports) == importBatchSize {
+			if err := s.importBatch(ctx, &batch); err != nil {
+				return err
+			}
+			batch = importBatch{}
+		}
+	}
+	if err := s.importBatch(ctx, &batch); err != nil {
+		return err
+	}
+	var created, updated, rejected int64
+	for _, result := range reply.Results {
+		switch result.GetStatus() {
+		case ImportStatus_IMPORT_CREATED:
+			created++
+		case ImportStatus_IMPORT_UPDATED:
+			updated++
+		case ImportStatus_IMPORT_REJECTED:
+			rejected++
+		}
+	}
+	reply.Created = &created
+	reply.Updated = &updated
+	reply.Rejected = &rejected
+	return stream.SendAndClose(reply)
+}
+
+func reject(result *ImportResult, err error) {
+	result.Status = ImportStatus_IMPORT_REJECTED
+	result.Reason = proto.String(status.Convert(err).Message())
+}
+
+func (s *Server) importBatch(ctx context.Context, batch *importBatch) error {
+	if len(batch.imports) == 0 {
+		return nil
+	}
+	var importResults []models.ImportResult
+	err := s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		importResults, err = s.Companies.Import(ctx, batch.imports)
+		if err != nil {
+			return err
+		}
+		for idx := range importResults {
+			if importResults[idx].Err != nil {
+				continue
+			}
+			err := s.recordImport(ctx, &batch.imports[idx], &importResults[idx])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for idx, importResult := range importResults {
+		result := batch.results[idx]
+		if importResult.Err != nil {
+			reject(result, importResult.Err)
+			continue
+		}
+		result.Id = proto.String(importResult.CompanyID.Hex())
+		result.Status = ImportStatus_IMPORT_CREATED
+		if importResult.Before != nil {
+			result.Status = ImportStatus_IMPORT_UPDATED
+		}
+	}
+	return nil
+}
+
+// recordImport records mutations of the imported company and its services
+// like AddCompany, UpdateCompany, AddService and UpdateService do.
+func (s *Server) recordImport(
+	ctx context.Context,
+	companyImport *models.CompanyImport,
+	importResult *models.ImportResult,
+) error {
+	after := companyImport.Company
+	after.ID = importResult.CompanyID
+	event := models.CompanyCreated
+	if importResult.Before != nil {
+		after = *importResult.Before
+		companyImport.Apply(&after)
+		event = models.CompanyUpdated
+	}
+	err := s.record(ctx, mutation{
+		rpc:       "ImportCompanies",
+		companyID: importResult.CompanyID,
+		changes:   models.CompanyDiff(importResult.Before, &after),
+		events:    []string{event},
+	})
+	if err != nil {
+		return err
+	}
+	for idx, serviceResult := range importResult.Services {
+		after := companyImport.Services[idx]
+		after.ID = serviceResult.ServiceID
+		after.CompanyID = importResult.CompanyID
+		events := []string{models.ServiceCreated}
+		if before := serviceResult.Before; before != nil {
+			events = []string{models.ServiceUpdated}
+			after.StaffIDs = before.StaffIDs
+			after.CategoryID = before.CategoryID
+			if before.Price != after.Price {
+				events = append(events, models.ServicePriceChanged)
+			}
+		}
+		err := s.record(ctx, mutation{
+			rpc:       "ImportCompanies",
+			companyID: importResult.CompanyID,
+			serviceID: serviceResult.ServiceID,
+			changes:   models.ServiceDiff(serviceResult.Before, &after),
+			events:    events,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package companiespb
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// maxPrice is the maximum price in minor units.
+const maxPrice = 100000000
+
+// errNoCurrency is returned when neither price nor its default has
+// currency.
+var errNoCurrency = errors.New(
+	"price.currency should be set, company does not have default currency",
+)
+
+// verifyCurrency checks that currency is an upper case ISO 4217 code.
+func verifyCurrency(currency *string) error {
+	if currency != nil && !models.IsCurrency(*currency) {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"%q is not an ISO 4217 currency code",
+			*currency,
+		)
+	}
+	return nil
+}
+
+// verifyMoney checks the price, zero amount is allowed.
+func verifyMoney(money *Money) error {
+	if money == nil {
+		return nil
+	}
+	err := verifyInteger(money.Amount, -1, maxPrice)
+	if err != nil {
+		return err
+	}
+	return verifyCurrency(money.Currency)
+}
+
+// newMoney returns the price with currency set to defaultCurrency unless
+// the price has one. Nil price is free.
+func newMoney(money *Money, defaultCurrency string) (models.Money, error) {
+	price := models.Money{
+		Amount:   money.GetAmount(),
+		Currency: money.GetCurrency(),
+	}
+	if price.Currency == "" {
+		price.Currency = defaultCurrency
+	}
+	if price.Currency == "" {
+		return price, errNoCurrency
+	}
+	return price, nil
+}
+
+func newMoneyProto(money models.Money) *Money {
+	return &Money{
+		Amount:   &money.Amount,
+		Currency: &money.Currency,
+	}
+}
@@ -0,0 +1,128 @@
+package companiespb
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/msik-404/micro-appoint-companies/internal/pagetoken"
+)
+
+// paginationFields are request fields which may change between pages of
+// the same list.
+var paginationFields = []protoreflect.Name{
+	"page_token",
+	"start_key",
+	"start_value",
+	"start_score",
+	"start_distance",
+	"n_per_page",
+	"with_total_count",
+}
+
+// requestScope hashes filters and sort order of the request, that is all
+// its fields except pagination ones.
+func requestScope(request proto.Message) (string, error) {
+	scoped := proto.Clone(request).ProtoReflect()
+	fields := scoped.Descriptor().Fields()
+	for _, name := range paginationFields {
+		if field := fields.ByName(name); field != nil {
+			scoped.Clear(field)
+		}
+	}
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(scoped.Interface())
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(encoded)
+	return base64.RawURLEncoding.EncodeToString(hash[:16]), nil
+}
+
+// readPageToken verifies page token of the request and returns start key
+// and start value stored in it. Without the token startKey and startValue
+// of the request are returned.
+func (s *Server) readPageToken(
+	request proto.Message,
+	pageToken *string,
+	startKey *string,
+	startValue *string,
+) (*string, *string, error) {
+	if pageToken == nil {
+		return startKey, startValue, nil
+	}
+	if startKey != nil || startValue != nil {
+		return nil, nil, status.Error(
+			codes.InvalidArgument,
+			"page_token can not be used together with start_key or start_value",
+		)
+	}
+	token, err := s.PageTokens.Decode(*pageToken)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scope, err := requestScope(request)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	if token.Scope != scope {
+		return nil, nil, status.Error(
+			codes.InvalidArgument,
+			"page_token was issued for different filters or sort order",
+		)
+	}
+	return &token.Key, &token.ID, nil
+}
+
+// pageSize validates n_per_page of a list request, 30 items are returned
+// by default.
+func pageSize(nPerPage *int64) (int64, error) {
+	err := verifyInteger(nPerPage, 0, 100)
+	if err != nil {
+		return 0, err
+	}
+	if nPerPage == nil {
+		return 30, nil
+	}
+	return *nPerPage, nil
+}
+
+// pageLimit returns limit of the storage query for a page. One item more
+// than nPerPage is queried to tell whether there are more items.
+func pageLimit(nPerPage int64) int64 {
+	return nPerPage + 1
+}
+
+// trimPage drops the extra item queried because of pageLimit and reports
+// whether it was there.
+func trimPage[T any](items []T, nPerPage int64) ([]T, bool) {
+	if int64(len(items)) <= nPerPage {
+		return items, false
+	}
+	return items[:nPerPage], true
+}
+
+// nextPageToken returns token pointing after item with key and id.
+func (s *Server) nextPageToken(
+	request proto.Message,
+	key string,
+	id primitive.ObjectID,
+) (*string, error) {
+	scope, err := requestScope(request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	token, err := s.PageTokens.Encode(pagetoken.Token{
+		Key:   key,
+		ID:    id.Hex(),
+		Scope: scope,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &token, nil
+}
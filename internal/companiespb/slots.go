@@ -0,0 +1,210 @@
+package companiespb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// slotsDaysLimit is the maximum number of days of a single
+// ListAvailableSlots request.
+const slotsDaysLimit = 31
+
+// busyLimit is the maximum number of busy ranges of a single
+// ListAvailableSlots request.
+const busyLimit = 1000
+
+func newTimeRange(timeRangeProto *TimeRange) (models.TimeRange, error) {
+	var timeRange models.TimeRange
+	if timeRangeProto.Start == nil || timeRangeProto.End == nil {
+		return timeRange, status.Error(codes.InvalidArgument, "start and end should be set")
+	}
+	if err := timeRangeProto.Start.CheckValid(); err != nil {
+		return timeRange, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := timeRangeProto.End.CheckValid(); err != nil {
+		return timeRange, status.Error(codes.InvalidArgument, err.Error())
+	}
+	timeRange.Start = timeRangeProto.Start.AsTime()
+	timeRange.End = timeRangeProto.End.AsTime()
+	if !timeRange.Start.Before(timeRange.End) {
+		return timeRange, status.Error(codes.InvalidArgument, "start should be before end")
+	}
+	return timeRange, nil
+}
+
+// newSlotOptions validates options of the request, Duration is set from the
+// service by the caller.
+func newSlotOptions(request *AvailableSlotsRequest) (models.SlotOptions, error) {
+	var opts models.SlotOptions
+	err := verifyInteger(request.Step, 0, 240)
+	if err != nil {
+		return opts, err
+	}
+	err = verifyInteger(request.BufferBefore, -1, 240)
+	if err != nil {
+		return opts, err
+	}
+	err = verifyInteger(request.BufferAfter, -1, 240)
+	if err != nil {
+		return opts, err
+	}
+	if len(request.GetBusy()) > busyLimit {
+		return opts, status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d busy ranges",
+			busyLimit,
+		)
+	}
+	opts.Step = 15 * time.Minute
+	if request.Step != nil {
+		opts.Step = time.Duration(request.GetStep()) * time.Minute
+	}
+	opts.BufferBefore = time.Duration(request.GetBufferBefore()) * time.Minute
+	opts.BufferAfter = time.Duration(request.GetBufferAfter()) * time.Minute
+	for _, busyProto := range request.GetBusy() {
+		busy, err := newTimeRange(busyProto)
+		if err != nil {
+			return opts, err
+		}
+		opts.Busy = append(opts.Busy, busy)
+	}
+	return opts, nil
+}
+
+// slotDuration returns duration of the service booked with variant and
+// add-ons selected by the request.
+func slotDuration(
+	serviceModel *models.Service,
+	request *AvailableSlotsRequest,
+) (time.Duration, error) {
+	duration := serviceModel.Duration
+	if request.Variant != nil {
+		idx := slices.IndexFunc(serviceModel.Variants, func(variant models.Variant) bool {
+			return variant.Name == request.GetVariant()
+		})
+		if idx < 0 {
+			return 0, status.Errorf(
+				codes.InvalidArgument,
+				"Service has no variant %q",
+				request.GetVariant(),
+			)
+		}
+		duration = serviceModel.Variants[idx].Duration
+	}
+	seen := make(map[string]bool, len(request.GetAddOns()))
+	for _, name := range request.GetAddOns() {
+		idx := slices.IndexFunc(serviceModel.AddOns, func(addOn models.AddOn) bool {
+			return addOn.Name == name
+		})
+		if idx < 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "Service has no add-on %q", name)
+		}
+		if seen[name] {
+			return 0, status.Errorf(codes.InvalidArgument, "%q is listed twice", name)
+		}
+		seen[name] = true
+		duration += serviceModel.AddOns[idx].Duration
+	}
+	if duration == 0 {
+		return 0, status.Error(
+			codes.FailedPrecondition,
+			"Service has no duration set",
+		)
+	}
+	return time.Duration(duration) * time.Minute, nil
+}
+
+func (s *Server) ListAvailableSlots(
+	ctx context.Context,
+	request *AvailableSlotsRequest,
+) (*AvailableSlotsReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	serviceID, err := primitive.ObjectIDFromHex(request.GetServiceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = verifyDateRange(request.StartDate, request.EndDate, slotsDaysLimit)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := newSlotOptions(request)
+	if err != nil {
+		return nil, err
+	}
+	companyModel, err := s.Companies.FindOne(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	hours := companyModel.OpeningHours
+	if hours == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			"Company has no opening hours set",
+		)
+	}
+	serviceModel, err := s.Services.FindOne(ctx, companyID, serviceID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company or service with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	opts.Duration, err = slotDuration(serviceModel, request)
+	if err != nil {
+		return nil, err
+	}
+	closures, err := s.Closures.FindMany(
+		ctx,
+		companyID,
+		request.GetStartDate(),
+		request.GetEndDate(),
+	)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	slots, err := models.AvailableSlots(
+		hours,
+		closures,
+		request.GetStartDate(),
+		request.GetEndDate(),
+		opts,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := &AvailableSlotsReply{TimeZone: &hours.TimeZone}
+	for _, slot := range slots {
+		reply.Slots = append(reply.Slots, &TimeRange{
+			Start: timestamppb.New(slot.Start),
+			End:   timestamppb.New(slot.End),
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,133 @@
+package companiespb
+
+import (
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+var companySortFields = map[CompanySortField]string{
+	CompanySortField_COMPANY_CREATED_AT: models.SortByCreation,
+	CompanySortField_COMPANY_NAME:       models.SortByName,
+}
+
+var serviceSortFields = map[ServiceSortField]string{
+	ServiceSortField_SERVICE_CREATED_AT: models.SortByCreation,
+	ServiceSortField_SERVICE_PRICE:      models.SortByPrice,
+	ServiceSortField_SERVICE_DURATION:   models.SortByDuration,
+	ServiceSortField_SERVICE_NAME:       models.SortByName,
+}
+
+func newSort(field string, ok bool, direction SortDirection) (models.Sort, error) {
+	if !ok {
+		return models.Sort{}, status.Error(codes.InvalidArgument, "Unknown sort field")
+	}
+	if direction != SortDirection_DESCENDING && direction != SortDirection_ASCENDING {
+		return models.Sort{}, status.Error(codes.InvalidArgument, "Unknown sort direction")
+	}
+	return models.Sort{
+		Field:     field,
+		Ascending: direction == SortDirection_ASCENDING,
+	}, nil
+}
+
+func newCompanySort(field CompanySortField, direction SortDirection) (models.Sort, error) {
+	modelField, ok := companySortFields[field]
+	return newSort(modelField, ok, direction)
+}
+
+func newServiceSort(field ServiceSortField, direction SortDirection) (models.Sort, error) {
+	modelField, ok := serviceSortFields[field]
+	return newSort(modelField, ok, direction)
+}
+
+// formatKey formats value of the sorted field the way newCursor parses it.
+func formatKey(key interface{}) string {
+	switch key := key.(type) {
+	case string:
+		return key
+	case int32:
+		return strconv.FormatInt(int64(key), 10)
+	case int64:
+		return strconv.FormatInt(key, 10)
+	case models.Money:
+		return key.Currency + " " + strconv.FormatInt(key.Amount, 10)
+	}
+	return ""
+}
+
+// formatFloatKey and parseFloatKey convert scores and distances, which are
+// keys of search results, so they survive round trip exactly.
+func formatFloatKey(key *float64) *string {
+	if key == nil {
+		return nil
+	}
+	formatted := strconv.FormatFloat(*key, 'g', -1, 64)
+	return &formatted
+}
+
+func parseFloatKey(key string) (float64, error) {
+	parsed, err := strconv.ParseFloat(key, 64)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return parsed, nil
+}
+
+// newCursor parses start_key and start_value of list requests. start_key
+// is required when sorting by anything else than creation time, when sorting
+// by price it is currency and amount separated by space, for example
+// "PLN 1999".
+func newCursor(
+	sort models.Sort,
+	startKey *string,
+	startValue *string,
+) (models.Cursor, error) {
+	var cursor models.Cursor
+	if startValue == nil {
+		return cursor, nil
+	}
+	var err error
+	cursor.ID, err = primitive.ObjectIDFromHex(*startValue)
+	if err != nil {
+		return cursor, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if sort.Field == models.SortByCreation {
+		return cursor, nil
+	}
+	if startKey == nil {
+		return cursor, status.Error(
+			codes.InvalidArgument,
+			"start_key should be set together with start_value",
+		)
+	}
+	switch sort.Field {
+	case models.SortByName:
+		cursor.Key = *startKey
+	case models.SortByPrice:
+		currency, amount, found := strings.Cut(*startKey, " ")
+		if !found {
+			return cursor, status.Error(
+				codes.InvalidArgument,
+				"start_key of price should be currency and amount",
+			)
+		}
+		key, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return cursor, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cursor.Key = models.Money{Amount: key, Currency: currency}
+	case models.SortByDuration:
+		key, err := strconv.ParseInt(*startKey, 10, 32)
+		if err != nil {
+			return cursor, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cursor.Key = int32(key)
+	}
+	return cursor, nil
+}
@@ -0,0 +1,351 @@
+package companiespb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// staffLimit is the maximum number of staff members of a single company.
+const staffLimit = 100
+
+var errTooManyStaff = fmt.Errorf(
+	"Company can have at most %d staff members",
+	staffLimit,
+)
+
+// errUnknownStaff is returned when assigned staff member does not belong
+// to the company.
+var errUnknownStaff = errors.New("Staff member does not belong to the company")
+
+func verifyStaff(name *string, role *string, bio *string) error {
+	err := verifyString(name, 30)
+	if err != nil {
+		return err
+	}
+	err = verifyString(role, 30)
+	if err != nil {
+		return err
+	}
+	return verifyString(bio, 300)
+}
+
+func newStaffProto(staffModel *models.Staff) *StaffMember {
+	staffID := staffModel.ID.Hex()
+	return &StaffMember{
+		Id:      &staffID,
+		Name:    &staffModel.Name,
+		Role:    &staffModel.Role,
+		Bio:     &staffModel.Bio,
+		Active:  &staffModel.Active,
+		Version: &staffModel.Version,
+	}
+}
+
+func (s *Server) AddStaff(
+	ctx context.Context,
+	request *AddStaffRequest,
+) (*AddStaffReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name should be set")
+	}
+	err = verifyStaff(request.Name, request.Role, request.Bio)
+	if err != nil {
+		return nil, err
+	}
+	staff := models.Staff{
+		Name:   request.GetName(),
+		Role:   request.GetRole(),
+		Bio:    request.GetBio(),
+		Active: request.Active == nil || request.GetActive(),
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		members, err := s.Staff.FindMany(ctx, companyID, false)
+		if err != nil {
+			return err
+		}
+		if len(members) >= staffLimit {
+			return errTooManyStaff
+		}
+		staff.ID, err = s.Staff.InsertOne(ctx, companyID, &staff)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, mutation{
+			rpc:       "AddStaff",
+			companyID: companyID,
+			changes:   models.StaffDiff(nil, &staff),
+			events:    []string{models.StaffCreated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, errTooManyStaff) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &AddStaffReply{Id: proto.String(staff.ID.Hex())}, nil
+}
+
+func (s *Server) UpdateStaff(
+	ctx context.Context,
+	request *UpdateStaffRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	staffID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = verifyStaff(request.Name, request.Role, request.Bio)
+	if err != nil {
+		return nil, err
+	}
+	staffUpdate := models.StaffUpdate{
+		Name:   request.Name,
+		Role:   request.Role,
+		Bio:    request.Bio,
+		Active: request.Active,
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Staff.FindOne(ctx, companyID, staffID)
+		if err != nil {
+			return err
+		}
+		err = s.Staff.UpdateOne(ctx, companyID, staffID, &staffUpdate, request.Version)
+		if err != nil {
+			return err
+		}
+		after := *before
+		staffUpdate.Apply(&after)
+		return s.record(ctx, mutation{
+			rpc:       "UpdateStaff",
+			companyID: companyID,
+			changes:   models.StaffDiff(before, &after),
+			events:    []string{models.StaffUpdated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Staff member was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Staff member with that companyID and staffID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DeleteStaff(
+	ctx context.Context,
+	request *DeleteStaffRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	staffID, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.Staff.FindOne(ctx, companyID, staffID)
+		if err != nil {
+			return err
+		}
+		services, err := s.Services.FindMany(
+			ctx,
+			companyID,
+			models.ServiceFilter{StaffID: staffID},
+			models.Sort{},
+			models.Cursor{},
+			0,
+		)
+		if err != nil {
+			return err
+		}
+		err = s.Staff.DeleteOne(ctx, companyID, staffID, request.Version)
+		if err != nil {
+			return err
+		}
+		err = s.record(ctx, mutation{
+			rpc:       "DeleteStaff",
+			companyID: companyID,
+			changes:   models.StaffDiff(before, nil),
+			events:    []string{models.StaffDeleted},
+		})
+		if err != nil {
+			return err
+		}
+		return s.recordCascade(
+			ctx,
+			"DeleteStaff",
+			companyID,
+			services,
+			func(service *models.Service) {
+				var staffIDs []primitive.ObjectID
+				for _, id := range service.StaffIDs {
+					if id != staffID {
+						staffIDs = append(staffIDs, id)
+					}
+				}
+				service.StaffIDs = staffIDs
+			},
+		)
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Staff member was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Staff member with that companyID and staffID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) FindManyStaff(
+	ctx context.Context,
+	request *StaffRequest,
+) (*StaffReply, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	staff, err := s.Staff.FindMany(ctx, companyID, request.GetActiveOnly())
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Company with that id was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := &StaffReply{}
+	for idx := range staff {
+		reply.Staff = append(reply.Staff, newStaffProto(&staff[idx]))
+	}
+	return reply, nil
+}
+
+// AssignStaff replaces staff of the service, it is recorded as update of
+// the service.
+func (s *Server) AssignStaff(
+	ctx context.Context,
+	request *AssignStaffRequest,
+) (*emptypb.Empty, error) {
+	companyID, err := primitive.ObjectIDFromHex(request.GetCompanyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	serviceID, err := primitive.ObjectIDFromHex(request.GetServiceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetStaffIds()) > staffLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d staff members",
+			staffLimit,
+		)
+	}
+	staffIDs := []primitive.ObjectID{}
+	seen := make(map[primitive.ObjectID]bool)
+	for _, hex := range request.GetStaffIds() {
+		staffID, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !seen[staffID] {
+			seen[staffID] = true
+			staffIDs = append(staffIDs, staffID)
+		}
+	}
+	serviceUpdate := models.ServiceUpdate{StaffIDs: &staffIDs}
+	err = s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		staff, err := s.Staff.FindMany(ctx, companyID, false)
+		if err != nil {
+			return err
+		}
+		known := make(map[primitive.ObjectID]bool, len(staff))
+		for _, member := range staff {
+			known[member.ID] = true
+		}
+		for _, staffID := range staffIDs {
+			if !known[staffID] {
+				return fmt.Errorf("%w: %s", errUnknownStaff, staffID.Hex())
+			}
+		}
+		before, err := s.Services.FindOne(ctx, companyID, serviceID)
+		if err != nil {
+			return err
+		}
+		err = s.Services.UpdateOne(ctx, companyID, serviceID, &serviceUpdate, request.Version)
+		if err != nil {
+			return err
+		}
+		after := *before
+		serviceUpdate.Apply(&after)
+		return s.record(ctx, mutation{
+			rpc:       "AssignStaff",
+			companyID: companyID,
+			serviceID: serviceID,
+			changes:   models.ServiceDiff(before, &after),
+			events:    []string{models.ServiceUpdated},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, errUnknownStaff) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return nil, status.Error(
+				codes.Aborted,
+				"Service was modified concurrently, version does not match",
+			)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"Service with that companyID and serviceID was not found",
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
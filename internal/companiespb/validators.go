@@ -1,6 +1,8 @@
 package companiespb 
 
 import (
+	"math"
+
 	"golang.org/x/exp/constraints"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,3 +34,100 @@ func verifyInteger[T constraints.Integer](value *T, low T, high T) error {
 	}
 	return nil
 }
+
+func verifyFloat(value *float64, low float64, high float64) error {
+	if value != nil {
+		if math.IsNaN(*value) || math.IsInf(*value, 0) {
+			return status.Error(
+				codes.InvalidArgument,
+				"Value should be a finite number",
+			)
+		}
+		if *value > high || *value < low {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"Value should be between %g and %g",
+				low,
+				high,
+			)
+		}
+	}
+	return nil
+}
+
+// verifyGeoPoint checks that both coordinates of the point are set and in
+// range.
+func verifyGeoPoint(point *GeoPoint) error {
+	if point == nil {
+		return nil
+	}
+	if point.Latitude == nil || point.Longitude == nil {
+		return status.Error(
+			codes.InvalidArgument,
+			"latitude and longitude should be set",
+		)
+	}
+	err := verifyFloat(point.Latitude, -90, 90)
+	if err != nil {
+		return err
+	}
+	return verifyFloat(point.Longitude, -180, 180)
+}
+
+// verifyAddCompany checks fields of the new company, it is shared by
+// AddCompany and ImportCompanies.
+func verifyAddCompany(request *AddCompanyRequest) error {
+	if request.Name == nil {
+		return status.Error(
+			codes.InvalidArgument,
+			"name should be set",
+		)
+	}
+	err := verifyString(request.Name, 30)
+	if err != nil {
+		return err
+	}
+	err = verifyString(request.Type, 30)
+	if err != nil {
+		return err
+	}
+	err = verifyString(request.Localisation, 60)
+	if err != nil {
+		return err
+	}
+	err = verifyString(request.ShortDescription, 150)
+	if err != nil {
+		return err
+	}
+	err = verifyString(request.LongDescription, 300)
+	if err != nil {
+		return err
+	}
+	err = verifyGeoPoint(request.Location)
+	if err != nil {
+		return err
+	}
+	return verifyCurrency(request.Currency)
+}
+
+// verifyAddService checks fields of the new service, it is shared by
+// AddService and ImportCompanies.
+func verifyAddService(request *AddServiceRequest) error {
+	err := verifyString(request.Name, 30)
+	if err != nil {
+		return err
+	}
+	err = verifyMoney(request.Price)
+	if err != nil {
+		return err
+	}
+	err = verifyInteger(request.Duration, 0, 480)
+	if err != nil {
+		return err
+	}
+	err = verifyString(request.Description, 300)
+	if err != nil {
+		return err
+	}
+	return verifyVariants(request.Variants, request.AddOns)
+}
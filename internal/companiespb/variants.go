@@ -0,0 +1,172 @@
+package companiespb
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// optionsLimit is the maximum number of variants and of add-ons of a
+// single service.
+const optionsLimit = 20
+
+// errMixedCurrencies is returned when variant or add-on is priced in other
+// currency than its service.
+var errMixedCurrencies = errors.New(
+	"Variants and add-ons should be priced in the currency of the service",
+)
+
+// verifyOption checks fields of a variant or an add-on, all of them are
+// required. Duration should be greater than minDuration, so variants take
+// some time while add-ons may take no time.
+func verifyOption(name *string, price *Money, duration *int32, minDuration int32) error {
+	if name == nil || *name == "" {
+		return status.Error(
+			codes.InvalidArgument,
+			"name of variants and add-ons should be set",
+		)
+	}
+	if price == nil || price.Amount == nil || duration == nil {
+		return status.Error(
+			codes.InvalidArgument,
+			"price and duration of variants and add-ons should be set",
+		)
+	}
+	err := verifyString(name, 30)
+	if err != nil {
+		return err
+	}
+	err = verifyMoney(price)
+	if err != nil {
+		return err
+	}
+	return verifyInteger(duration, minDuration, 480)
+}
+
+// verifyOptionNames checks that there are at most optionsLimit names and
+// that they are unique.
+func verifyOptionNames(names []string) error {
+	if len(names) > optionsLimit {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"There should be at most %d variants and %d add-ons",
+			optionsLimit,
+			optionsLimit,
+		)
+	}
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return status.Errorf(codes.InvalidArgument, "%q is listed twice", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// verifyVariants checks variants and add-ons of the service, it is shared
+// by AddService, UpdateService and ImportCompanies.
+func verifyVariants(variants []*ServiceVariant, addOns []*ServiceAddOn) error {
+	var names []string
+	for _, variant := range variants {
+		err := verifyOption(variant.Name, variant.Price, variant.Duration, 0)
+		if err != nil {
+			return err
+		}
+		names = append(names, variant.GetName())
+	}
+	err := verifyOptionNames(names)
+	if err != nil {
+		return err
+	}
+	names = nil
+	for _, addOn := range addOns {
+		err := verifyOption(addOn.Name, addOn.Price, addOn.Duration, -1)
+		if err != nil {
+			return err
+		}
+		names = append(names, addOn.GetName())
+	}
+	return verifyOptionNames(names)
+}
+
+// newVariants returns variants priced by default in currency.
+func newVariants(variants []*ServiceVariant, currency string) ([]models.Variant, error) {
+	var variantModels []models.Variant
+	for _, variant := range variants {
+		price, err := newMoney(variant.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		variantModels = append(variantModels, models.Variant{
+			Name:     variant.GetName(),
+			Price:    price,
+			Duration: variant.GetDuration(),
+		})
+	}
+	return variantModels, nil
+}
+
+// newAddOns returns add-ons priced by default in currency.
+func newAddOns(addOns []*ServiceAddOn, currency string) ([]models.AddOn, error) {
+	var addOnModels []models.AddOn
+	for _, addOn := range addOns {
+		price, err := newMoney(addOn.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		addOnModels = append(addOnModels, models.AddOn{
+			Name:     addOn.GetName(),
+			Price:    price,
+			Duration: addOn.GetDuration(),
+		})
+	}
+	return addOnModels, nil
+}
+
+// checkCurrencies checks that variants and add-ons are priced in the
+// currency of the service, so their prices can be summed up.
+func checkCurrencies(service *models.Service) error {
+	currency := service.Price.Currency
+	for _, variant := range service.Variants {
+		if variant.Price.Currency != currency {
+			return fmt.Errorf("%w: %s", errMixedCurrencies, variant.Name)
+		}
+	}
+	for _, addOn := range service.AddOns {
+		if addOn.Price.Currency != currency {
+			return fmt.Errorf("%w: %s", errMixedCurrencies, addOn.Name)
+		}
+	}
+	return nil
+}
+
+func newVariantProtos(variants []models.Variant) []*ServiceVariant {
+	var variantProtos []*ServiceVariant
+	for idx := range variants {
+		variant := &variants[idx]
+		variantProtos = append(variantProtos, &ServiceVariant{
+			Name:     &variant.Name,
+			Price:    newMoneyProto(variant.Price),
+			Duration: &variant.Duration,
+		})
+	}
+	return variantProtos
+}
+
+func newAddOnProtos(addOns []models.AddOn) []*ServiceAddOn {
+	var addOnProtos []*ServiceAddOn
+	for idx := range addOns {
+		addOn := &addOns[idx]
+		addOnProtos = append(addOnProtos, &ServiceAddOn{
+			Name:     &addOn.Name,
+			Price:    newMoneyProto(addOn.Price),
+			Duration: &addOn.Duration,
+		})
+	}
+	return addOnProtos
+}
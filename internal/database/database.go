@@ -15,6 +15,26 @@ var DBName = os.Getenv("DB_NAME")
 
 const CollName string = "companies"
 
+const ServicesCollName string = "services"
+
+const AuditCollName string = "audit_events"
+
+const OutboxCollName string = "outbox"
+
+const ClosuresCollName string = "closures"
+
+const StaffCollName string = "staff"
+
+const CategoriesCollName string = "categories"
+
+// TextWeights of company fields in full-text search.
+var TextWeights = bson.D{
+	{Key: "name", Value: 10},
+	{Key: "type", Value: 5},
+	{Key: "short_description", Value: 2},
+	{Key: "long_description", Value: 1},
+}
+
 func getURI() string {
 	return fmt.Sprintf(
 		"mongodb://%s:%s@%s:27017",
@@ -46,16 +66,162 @@ func CreateDBIndexes(client *mongo.Client) ([]string, error) {
 			Keys: bson.M{"type": 1},
 		},
 		{
-			Keys: bson.M{"services.service_id": 1},
+			Keys:    bson.M{"deleted_at": 1},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "type", Value: "text"},
+				{Key: "short_description", Value: "text"},
+				{Key: "long_description", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("companies_text").
+				SetWeights(TextWeights),
+		},
+		{
+			Keys: bson.M{"location": "2dsphere"},
 		},
 		{
 			Keys: bson.D{
+				{Key: "name", Value: 1},
 				{Key: "_id", Value: 1},
-				{Key: "services.service_id", Value: 1},
 			},
 		},
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
-	return coll.Indexes().CreateMany(ctx, index)
+	names, err := coll.Indexes().CreateMany(ctx, index)
+	if err != nil {
+		return names, err
+	}
+	servicesColl := db.Collection(ServicesCollName)
+	servicesIndex := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "price.currency", Value: 1},
+				{Key: "price.amount", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "duration", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "name", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "staff_ids", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "category_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+	}
+	servicesNames, err := servicesColl.Indexes().CreateMany(ctx, servicesIndex)
+	names = append(names, servicesNames...)
+	if err != nil {
+		return names, err
+	}
+	closuresColl := db.Collection(ClosuresCollName)
+	closuresIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "company_id", Value: 1},
+			{Key: "start_date", Value: 1},
+			{Key: "_id", Value: 1},
+		},
+	}
+	closuresName, err := closuresColl.Indexes().CreateOne(ctx, closuresIndex)
+	names = append(names, closuresName)
+	if err != nil {
+		return names, err
+	}
+	staffColl := db.Collection(StaffCollName)
+	staffIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "company_id", Value: 1},
+			{Key: "_id", Value: 1},
+		},
+	}
+	staffName, err := staffColl.Indexes().CreateOne(ctx, staffIndex)
+	names = append(names, staffName)
+	if err != nil {
+		return names, err
+	}
+	categoriesColl := db.Collection(CategoriesCollName)
+	categoriesIndex := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "position", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+		},
+		// Names of categories are unique within company.
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+	categoriesNames, err := categoriesColl.Indexes().CreateMany(ctx, categoriesIndex)
+	names = append(names, categoriesNames...)
+	if err != nil {
+		return names, err
+	}
+	auditColl := db.Collection(AuditCollName)
+	auditIndex := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "company_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.M{"created_at": 1},
+		},
+	}
+	auditNames, err := auditColl.Indexes().CreateMany(ctx, auditIndex)
+	names = append(names, auditNames...)
+	if err != nil {
+		return names, err
+	}
+	outboxColl := db.Collection(OutboxCollName)
+	// Unpublished events have no published_at, which is indexed as null,
+	// and are relayed in _id order. Partial indexes can not be used here,
+	// they neither accept $exists: false nor _id as the only key.
+	outboxIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "published_at", Value: 1},
+			{Key: "_id", Value: 1},
+		},
+		Options: options.Index().SetName("unpublished"),
+	}
+	outboxName, err := outboxColl.Indexes().CreateOne(ctx, outboxIndex)
+	return append(names, outboxName), err
 }
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TestCreateDBIndexes creates indexes in a real MongoDB, which validates
+// index options unlike the memory backend. It runs only when
+// MONGO_TEST_URI is set, for example to mongodb://localhost:27017.
+func TestCreateDBIndexes(t *testing.T) {
+	uri := os.Getenv("MONGO_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_TEST_URI is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect(context.Background())
+
+	dbName := DBName
+	DBName = "companies_indexes_test"
+	defer func() {
+		client.Database(DBName).Drop(context.Background())
+		DBName = dbName
+	}()
+	// Indexes are created twice, like on every start of the service.
+	for i := 0; i < 2; i++ {
+		if _, err := CreateDBIndexes(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
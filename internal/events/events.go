@@ -0,0 +1,129 @@
+// Package events publishes domain events written to the outbox to other
+// micro-appoint services.
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// Event is a domain event as seen by publishers. Type is one of event types
+// defined in the models package, for example models.CompanyCreated.
+type Event struct {
+	ID         string
+	Type       string
+	CompanyID  string
+	ServiceID  string
+	Payload    []byte
+	OccurredAt time.Time
+}
+
+func fromOutbox(outboxEvent *models.OutboxEvent) Event {
+	event := Event{
+		ID:         outboxEvent.ID.Hex(),
+		Type:       outboxEvent.Type,
+		CompanyID:  outboxEvent.CompanyID.Hex(),
+		Payload:    []byte(outboxEvent.Payload),
+		OccurredAt: outboxEvent.OccurredAt,
+	}
+	if !outboxEvent.ServiceID.IsZero() {
+		event.ServiceID = outboxEvent.ServiceID.Hex()
+	}
+	return event
+}
+
+// EventPublisher delivers events to a message broker. Events are delivered
+// at least once, so consumers should deduplicate them by ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// LocalPublisher keeps published events in memory. It is an in-process
+// stand-in for a real broker.
+type LocalPublisher struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (p *LocalPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events returns all events published so far in publishing order.
+func (p *LocalPublisher) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Event(nil), p.events...)
+}
+
+// LogPublisher writes events to the standard logger.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(ctx context.Context, event Event) error {
+	log.Printf(
+		"event %s %s company=%s service=%s payload=%s",
+		event.ID,
+		event.Type,
+		event.CompanyID,
+		event.ServiceID,
+		event.Payload,
+	)
+	return nil
+}
+
+// Relay moves events from the outbox to the publisher in order they were
+// written.
+type Relay struct {
+	Outbox    models.OutboxRepository
+	Publisher EventPublisher
+	BatchSize int64
+}
+
+// RelayOnce publishes one batch of pending events and returns number of
+// published ones. It stops at the first failure so that events keep their
+// order, the failed event is retried on the next call.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	pending, err := r.Outbox.FindUnpublished(ctx, r.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for idx := range pending {
+		if err := r.Publisher.Publish(ctx, fromOutbox(&pending[idx])); err != nil {
+			return idx, err
+		}
+		err := r.Outbox.MarkPublished(ctx, pending[idx].ID, time.Now().UTC())
+		if err != nil {
+			return idx, err
+		}
+	}
+	return len(pending), nil
+}
+
+// Run relays events every interval until ctx is done.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for {
+			published, err := r.RelayOnce(ctx)
+			if err != nil {
+				log.Printf("relaying outbox events failed: %v", err)
+			}
+			if err != nil || published == 0 || int64(published) < r.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+	"github.com/msik-404/micro-appoint-companies/internal/models/memory"
+)
+
+type failingPublisher struct {
+	LocalPublisher
+	failType string
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, event Event) error {
+	if event.Type == p.failType {
+		return errors.New("broker is down")
+	}
+	return p.LocalPublisher.Publish(ctx, event)
+}
+
+func TestRelayKeepsOrderOnFailure(t *testing.T) {
+	ctx := context.Background()
+	outbox := memory.NewStore().Outbox()
+	companyID := primitive.NewObjectID()
+	for _, eventType := range []string{
+		models.CompanyCreated,
+		models.CompanyUpdated,
+		models.CompanyDeleted,
+	} {
+		event := models.OutboxEvent{Type: eventType, CompanyID: companyID}
+		if err := outbox.InsertOne(ctx, &event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	publisher := &failingPublisher{failType: models.CompanyUpdated}
+	relay := &Relay{Outbox: outbox, Publisher: publisher, BatchSize: 10}
+	published, err := relay.RelayOnce(ctx)
+	if err == nil || published != 1 {
+		t.Fatalf("expected failure after first event: %d, %v", published, err)
+	}
+
+	publisher.failType = ""
+	published, err = relay.RelayOnce(ctx)
+	if err != nil || published != 2 {
+		t.Fatalf("expected remaining events to be published: %d, %v", published, err)
+	}
+	events := publisher.Events()
+	if events[0].Type != models.CompanyCreated ||
+		events[1].Type != models.CompanyUpdated ||
+		events[2].Type != models.CompanyDeleted {
+		t.Fatalf("events were published out of order: %+v", events)
+	}
+}
@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// All migrations of this service. New migrations are appended with the
+// next version number, released ones should never be changed.
+var All = []Migration{
+	{
+		Version:     1,
+		Description: "move embedded services to services collection",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := models.MigrateEmbeddedServices(ctx, db)
+			return err
+		},
+	},
+	{
+		Version:     2,
+		Description: "add version to companies and services",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			// Migration 1 used to store services with version 0.
+			filter := bson.M{"version": bson.M{"$in": bson.A{nil, 0}}}
+			update := bson.M{"$set": bson.M{"version": 1}}
+			return updateMany(ctx, db, filter, update)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			update := bson.M{"$unset": bson.M{"version": ""}}
+			return updateMany(ctx, db, bson.M{}, update)
+		},
+	},
+	{
+		Version:     3,
+		Description: "store service prices as money with currency",
+		// Existing prices are taken as whole units of LEGACY_PRICE_CURRENCY,
+		// which is also set as default currency of companies.
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			currency := os.Getenv("LEGACY_PRICE_CURRENCY")
+			_, err := models.MigratePrices(ctx, db, currency)
+			if err != nil {
+				return fmt.Errorf("LEGACY_PRICE_CURRENCY: %w", err)
+			}
+			return nil
+		},
+	},
+	{
+		Version:     4,
+		Description: "store empty names and durations",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := models.MigrateSortedFields(ctx, db)
+			return err
+		},
+	},
+}
+
+// updateMany applies update to both companies and services collections.
+func updateMany(ctx context.Context, db *mongo.Database, filter any, update any) error {
+	for _, collName := range []string{database.CollName, database.ServicesCollName} {
+		_, err := db.Collection(collName).UpdateMany(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+// Package migrations evolves the shape of documents stored in MongoDB.
+// Applied migrations are recorded in the schema_migrations collection and
+// a lock document guarantees that only one replica migrates at a time.
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	CollName     string = "schema_migrations"
+	LockCollName string = "schema_migrations_lock"
+	lockID       string = "lock"
+)
+
+// ErrLocked is returned when other process holds the migration lock.
+var ErrLocked = errors.New("migrations are locked by other process")
+
+// ErrIrreversible is returned by Down for migrations without Down step.
+var ErrIrreversible = errors.New("migration can not be reverted")
+
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	// Down is optional, migrations without it can not be reverted.
+	Down func(ctx context.Context, db *mongo.Database) error
+}
+
+type record struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+type lock struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+type Status struct {
+	Version     int
+	Description string
+	// AppliedAt is nil for pending migrations.
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	DB         *mongo.Database
+	Migrations []Migration
+	// Owner identifies this process in the lock document.
+	Owner string
+	// LockTTL after which lock of crashed process is considered stale.
+	LockTTL time.Duration
+}
+
+// New returns Migrator with all migrations of this service.
+func New(db *mongo.Database) *Migrator {
+	hostname, _ := os.Hostname()
+	return &Migrator{
+		DB:         db,
+		Migrations: All,
+		Owner:      fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		LockTTL:    10 * time.Minute,
+	}
+}
+
+// Validate checks that migrations have unique versions in ascending order.
+func Validate(migrations []Migration) error {
+	for idx, migration := range migrations {
+		if migration.Version <= 0 {
+			return fmt.Errorf("migration version should be positive: %d", migration.Version)
+		}
+		if migration.Up == nil {
+			return fmt.Errorf("migration %d does not have Up step", migration.Version)
+		}
+		if idx > 0 && migrations[idx-1].Version >= migration.Version {
+			return fmt.Errorf(
+				"migration %d should come before %d",
+				migration.Version,
+				migrations[idx-1].Version,
+			)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) lock(ctx context.Context) error {
+	coll := m.DB.Collection(LockCollName)
+	now := time.Now()
+	filter := bson.M{
+		"_id": lockID,
+		"$or": bson.A{
+			bson.M{"owner": m.Owner},
+			bson.M{"expires_at": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"owner":      m.Owner,
+		"expires_at": now.Add(m.LockTTL),
+	}}
+	// When lock is held by other process upsert fails on _id.
+	opts := options.Update().SetUpsert(true)
+	_, err := coll.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrLocked
+	}
+	return err
+}
+
+// Lock blocks until migration lock is acquired or ctx is done.
+func (m *Migrator) Lock(ctx context.Context) error {
+	for {
+		err := m.lock(ctx)
+		if !errors.Is(err, ErrLocked) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ErrLocked
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (m *Migrator) Unlock(ctx context.Context) error {
+	coll := m.DB.Collection(LockCollName)
+	_, err := coll.DeleteOne(ctx, bson.M{"_id": lockID, "owner": m.Owner})
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]record, error) {
+	coll := m.DB.Collection(CollName)
+	cursor, err := coll.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	applied := make(map[int]record, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// renew extends the lock every third of LockTTL until ctx is done, so
+// migrations may run longer than LockTTL. When other process took the
+// lock over, cancel is called and ErrLocked returned.
+func (m *Migrator) renew(ctx context.Context, cancel context.CancelFunc) error {
+	ticker := time.NewTicker(m.LockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// Other errors are retried, the lock is still valid until
+			// it expires.
+			if err := m.lock(ctx); errors.Is(err, ErrLocked) {
+				cancel()
+				return err
+			}
+		}
+	}
+}
+
+// withLock runs fn while holding migration lock. ctx passed to fn is
+// canceled when the lock is lost.
+func (m *Migrator) withLock(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) (err error) {
+	if err := Validate(m.Migrations); err != nil {
+		return err
+	}
+	if err := m.Lock(ctx); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	renewed := make(chan error, 1)
+	go func() {
+		renewed <- m.renew(ctx, cancel)
+	}()
+	defer func() {
+		cancel()
+		if renewErr := <-renewed; renewErr != nil {
+			err = renewErr
+			return
+		}
+		unlockErr := m.Unlock(context.Background())
+		if err == nil {
+			err = unlockErr
+		}
+	}()
+	return fn(ctx)
+}
+
+// Up applies all pending migrations in order and returns their versions.
+func (m *Migrator) Up(ctx context.Context) (versions []int, err error) {
+	err = m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		coll := m.DB.Collection(CollName)
+		for _, migration := range m.Migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if err := migration.Up(ctx, m.DB); err != nil {
+				return fmt.Errorf("migration %d: %w", migration.Version, err)
+			}
+			// The lock is checked once more, migration must not be
+			// recorded by process which no longer owns it.
+			if err := m.lock(ctx); err != nil {
+				return fmt.Errorf("migration %d: %w", migration.Version, err)
+			}
+			_, err := coll.InsertOne(ctx, record{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+			versions = append(versions, migration.Version)
+		}
+		return nil
+	})
+	return versions, err
+}
+
+// Down reverts the most recently applied migration and returns its
+// version. Zero is returned when nothing was applied.
+func (m *Migrator) Down(ctx context.Context) (version int, err error) {
+	err = m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		for idx := len(m.Migrations) - 1; idx >= 0; idx-- {
+			migration := m.Migrations[idx]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == nil {
+				return fmt.Errorf("migration %d: %w", migration.Version, ErrIrreversible)
+			}
+			if err := migration.Down(ctx, m.DB); err != nil {
+				return fmt.Errorf("migration %d: %w", migration.Version, err)
+			}
+			coll := m.DB.Collection(CollName)
+			_, err := coll.DeleteOne(ctx, bson.M{"_id": migration.Version})
+			if err != nil {
+				return err
+			}
+			version = migration.Version
+			return nil
+		}
+		return nil
+	})
+	return version, err
+}
+
+// Status lists known migrations together with applied ones which are no
+// longer known to this binary.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []Status
+	for _, migration := range m.Migrations {
+		status := Status{
+			Version:     migration.Version,
+			Description: migration.Description,
+		}
+		if r, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &r.AppliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, r := range applied {
+		r := r
+		statuses = append(statuses, Status{
+			Version:     r.Version,
+			Description: r.Description,
+			AppliedAt:   &r.AppliedAt,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
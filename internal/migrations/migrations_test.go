@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestAllMigrationsAreValid(t *testing.T) {
+	if err := Validate(All); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	up := func(context.Context, *mongo.Database) error { return nil }
+	tests := []struct {
+		name       string
+		migrations []Migration
+		valid      bool
+	}{
+		{"empty", nil, true},
+		{"ordered", []Migration{{Version: 1, Up: up}, {Version: 3, Up: up}}, true},
+		{"unordered", []Migration{{Version: 2, Up: up}, {Version: 1, Up: up}}, false},
+		{"duplicated", []Migration{{Version: 1, Up: up}, {Version: 1, Up: up}}, false},
+		{"zero version", []Migration{{Version: 0, Up: up}}, false},
+		{"missing up", []Migration{{Version: 1}}, false},
+	}
+	for _, test := range tests {
+		err := Validate(test.migrations)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package models
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// FieldChange is a single changed field of audited document. Before is
+// empty for created documents and After for deleted ones.
+type FieldChange struct {
+	Field  string `bson:"field" json:"field"`
+	Before string `bson:"before,omitempty" json:"before,omitempty"`
+	After  string `bson:"after,omitempty" json:"after,omitempty"`
+}
+
+// AuditEvent records who changed what through one of mutating RPCs.
+// Audit events are never updated nor deleted.
+type AuditEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Actor     string             `bson:"actor"`
+	RPC       string             `bson:"rpc"`
+	CompanyID primitive.ObjectID `bson:"company_id"`
+	ServiceID primitive.ObjectID `bson:"service_id,omitempty"`
+	Changes   []FieldChange      `bson:"changes,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// AuditFilter narrows listed audit events. Zero values match everything.
+type AuditFilter struct {
+	CompanyID primitive.ObjectID
+	// From is inclusive and To exclusive.
+	From *time.Time
+	To   *time.Time
+}
+
+type field struct {
+	name  string
+	value string
+}
+
+func companyFields(company *Company) []field {
+	if company == nil {
+		return nil
+	}
+	location := ""
+	if company.Location != nil {
+		location = company.Location.String()
+	}
+	return []field{
+		{"name", company.Name},
+		{"type", company.Type},
+		{"localisation", company.Localisation},
+		{"short_description", company.ShortDescription},
+		{"long_description", company.LongDescription},
+		{"location", location},
+		{"opening_hours", company.OpeningHours.String()},
+		{"currency", company.Currency},
+	}
+}
+
+func serviceFields(service *Service) []field {
+	if service == nil {
+		return nil
+	}
+	return []field{
+		{"name", service.Name},
+		{"price", service.Price.String()},
+		{"duration", strconv.FormatInt(int64(service.Duration), 10)},
+		{"description", service.Description},
+		{"staff_ids", joinIDs(service.StaffIDs)},
+		{"category_id", optionalID(service.CategoryID)},
+		{"variants", formatVariants(service.Variants)},
+		{"add_ons", formatAddOns(service.AddOns)},
+	}
+}
+
+// optionalID formats zero id as empty value.
+func optionalID(id primitive.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
+func categoryFields(category *Category) []field {
+	if category == nil {
+		return nil
+	}
+	return []field{
+		{"category_id", category.ID.Hex()},
+		{"name", category.Name},
+		{"position", strconv.FormatInt(int64(category.Position), 10)},
+	}
+}
+
+func joinIDs(ids []primitive.ObjectID) string {
+	hexes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexes = append(hexes, id.Hex())
+	}
+	return strings.Join(hexes, ",")
+}
+
+func staffFields(staff *Staff) []field {
+	if staff == nil {
+		return nil
+	}
+	return []field{
+		{"staff_id", staff.ID.Hex()},
+		{"name", staff.Name},
+		{"role", staff.Role},
+		{"bio", staff.Bio},
+		{"active", strconv.FormatBool(staff.Active)},
+	}
+}
+
+func closureFields(closure *Closure) []field {
+	if closure == nil {
+		return nil
+	}
+	return []field{
+		{"closure_id", closure.ID.Hex()},
+		{"start_date", closure.StartDate},
+		{"end_date", closure.EndDate},
+		{"reason", closure.Reason},
+	}
+}
+
+// diffFields compares fields listed in the same order, one of the lists
+// may be empty.
+func diffFields(before []field, after []field) []FieldChange {
+	var changes []FieldChange
+	for idx := 0; idx < len(before) || idx < len(after); idx++ {
+		var change FieldChange
+		if idx < len(before) {
+			change.Field = before[idx].name
+			change.Before = before[idx].value
+		}
+		if idx < len(after) {
+			change.Field = after[idx].name
+			change.After = after[idx].value
+		}
+		if change.Before != change.After {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// CompanyDiff lists fields which differ between two versions of the
+// company. Nil before means created company and nil after deleted one.
+func CompanyDiff(before *Company, after *Company) []FieldChange {
+	return diffFields(companyFields(before), companyFields(after))
+}
+
+// ServiceDiff is CompanyDiff for services.
+func ServiceDiff(before *Service, after *Service) []FieldChange {
+	return diffFields(serviceFields(before), serviceFields(after))
+}
+
+// StaffDiff is CompanyDiff for staff members.
+func StaffDiff(before *Staff, after *Staff) []FieldChange {
+	return diffFields(staffFields(before), staffFields(after))
+}
+
+// CategoryDiff is CompanyDiff for categories.
+func CategoryDiff(before *Category, after *Category) []FieldChange {
+	return diffFields(categoryFields(before), categoryFields(after))
+}
+
+// ClosureDiff is CompanyDiff for closures, they are never updated.
+func ClosureDiff(before *Closure, after *Closure) []FieldChange {
+	return diffFields(closureFields(before), closureFields(after))
+}
+
+func (event *AuditEvent) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+) (*mongo.InsertOneResult, error) {
+	coll := db.Collection(database.AuditCollName)
+	return coll.InsertOne(ctx, event)
+}
+
+func FindManyAuditEvents(
+	ctx context.Context,
+	db *mongo.Database,
+	auditFilter AuditFilter,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) (*mongo.Cursor, error) {
+	opts := options.Find()
+	opts.SetSort(bson.M{"_id": -1})
+	opts.SetLimit(nPerPage)
+
+	filter := bson.A{}
+	if !auditFilter.CompanyID.IsZero() {
+		filter = append(filter, bson.M{"company_id": auditFilter.CompanyID})
+	}
+	if auditFilter.From != nil {
+		filter = append(filter, bson.M{"created_at": bson.M{"$gte": *auditFilter.From}})
+	}
+	if auditFilter.To != nil {
+		filter = append(filter, bson.M{"created_at": bson.M{"$lt": *auditFilter.To}})
+	}
+	if !startValue.IsZero() {
+		filter = append(filter, bson.M{"_id": bson.M{"$lt": startValue}})
+	}
+	coll := db.Collection(database.AuditCollName)
+	if len(filter) == 0 {
+		return coll.Find(ctx, bson.M{}, opts)
+	}
+	return coll.Find(ctx, bson.M{"$and": filter}, opts)
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// Category groups services of the company, categories are shown in order
+// of Position.
+type Category struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID primitive.ObjectID `bson:"company_id,omitempty"`
+	Name      string             `bson:"name"`
+	Position  int32              `bson:"position"`
+	// Version is incremented on every update of the category.
+	Version int64 `bson:"version"`
+}
+
+type CategoryUpdate struct {
+	Name     *string `bson:"name,omitempty"`
+	Position *int32  `bson:"position,omitempty"`
+}
+
+// Apply sets fields of the update on the category.
+func (categoryUpdate *CategoryUpdate) Apply(category *Category) {
+	if categoryUpdate.Name != nil {
+		category.Name = *categoryUpdate.Name
+	}
+	if categoryUpdate.Position != nil {
+		category.Position = *categoryUpdate.Position
+	}
+}
+
+// InsertOne adds category to the company. Callers should check that
+// company exists with CompanyExists.
+func (category *Category) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.InsertOneResult, error) {
+	category.ID = primitive.NewObjectID()
+	category.CompanyID = companyID
+	category.Version = 1
+
+	coll := db.Collection(database.CategoriesCollName)
+	return coll.InsertOne(ctx, category)
+}
+
+// UpdateOne updates the category and increments its version, version
+// works like in ServiceUpdate.UpdateOne.
+func (categoryUpdate *CategoryUpdate) UpdateOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	version *int64,
+) (*mongo.UpdateResult, error) {
+	coll := db.Collection(database.CategoriesCollName)
+	filter := serviceFilter(companyID, categoryID, version)
+	update := bson.M{
+		"$set": categoryUpdate,
+		"$inc": bson.M{"version": 1},
+	}
+	return coll.UpdateOne(ctx, filter, update)
+}
+
+// DeleteOneCategory deletes the category, its services are left without
+// category. When version is not nil, category is deleted only if its
+// version is equal.
+func DeleteOneCategory(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	version *int64,
+) (*mongo.DeleteResult, error) {
+	coll := db.Collection(database.CategoriesCollName)
+	result, err := coll.DeleteOne(ctx, serviceFilter(companyID, categoryID, version))
+	if err != nil || result.DeletedCount == 0 {
+		return result, err
+	}
+	servicesColl := db.Collection(database.ServicesCollName)
+	_, err = servicesColl.UpdateMany(
+		ctx,
+		bson.M{"company_id": companyID, "category_id": categoryID},
+		bson.M{
+			"$unset": bson.M{"category_id": ""},
+			"$inc":   bson.M{"version": 1},
+		},
+	)
+	return result, err
+}
+
+func FindOneCategory(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+) *mongo.SingleResult {
+	coll := db.Collection(database.CategoriesCollName)
+	return coll.FindOne(ctx, serviceFilter(companyID, categoryID, nil))
+}
+
+// CategoryExists reports whether category with categoryID belongs to the
+// company.
+func CategoryExists(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+) (bool, error) {
+	coll := db.Collection(database.CategoriesCollName)
+	filter := serviceFilter(companyID, categoryID, nil)
+	count, err := coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	return count != 0, err
+}
+
+// FindManyCategories returns categories of the company ordered by position,
+// categories with equal position from the oldest.
+func FindManyCategories(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.Cursor, error) {
+	opts := options.Find()
+	opts.SetSort(bson.D{
+		{Key: "position", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	coll := db.Collection(database.CategoriesCollName)
+	return coll.Find(ctx, bson.M{"company_id": companyID}, opts)
+}
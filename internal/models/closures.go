@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// Closure is a range of days on which the company is closed regardless of
+// its opening hours, for example holidays or renovation.
+type Closure struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID primitive.ObjectID `bson:"company_id,omitempty"`
+	// StartDate and EndDate are inclusive, in DateLayout format and in the
+	// company time zone.
+	StartDate string `bson:"start_date"`
+	EndDate   string `bson:"end_date"`
+	Reason    string `bson:"reason,omitempty"`
+}
+
+// Covers tells whether the closure includes date in DateLayout format.
+func (closure *Closure) Covers(date string) bool {
+	return closure.StartDate <= date && date <= closure.EndDate
+}
+
+// InsertOne adds closure to the company. Callers should check that company
+// exists with CompanyExists.
+func (closure *Closure) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.InsertOneResult, error) {
+	closure.ID = primitive.NewObjectID()
+	closure.CompanyID = companyID
+
+	coll := db.Collection(database.ClosuresCollName)
+	return coll.InsertOne(ctx, closure)
+}
+
+func FindOneClosure(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) *mongo.SingleResult {
+	coll := db.Collection(database.ClosuresCollName)
+	filter := bson.M{"_id": closureID, "company_id": companyID}
+	return coll.FindOne(ctx, filter)
+}
+
+func DeleteOneClosure(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) (*mongo.DeleteResult, error) {
+	coll := db.Collection(database.ClosuresCollName)
+	filter := bson.M{"_id": closureID, "company_id": companyID}
+	return coll.DeleteOne(ctx, filter)
+}
+
+// FindManyClosures returns closures of the company which overlap days from
+// startDate to endDate, ordered by start date. Empty dates leave the range
+// open. Dates in DateLayout format compare like strings.
+func FindManyClosures(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	startDate string,
+	endDate string,
+) (*mongo.Cursor, error) {
+	opts := options.Find()
+	opts.SetSort(bson.D{
+		{Key: "start_date", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	filter := bson.A{bson.M{"company_id": companyID}}
+	if startDate != "" {
+		filter = append(filter, bson.M{"end_date": bson.M{"$gte": startDate}})
+	}
+	if endDate != "" {
+		filter = append(filter, bson.M{"start_date": bson.M{"$lte": endDate}})
+	}
+	coll := db.Collection(database.ClosuresCollName)
+	return coll.Find(ctx, bson.M{"$and": filter}, opts)
+}
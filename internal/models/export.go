@@ -0,0 +1,98 @@
+package models
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// exportBatchSize is the number of companies whose services are fetched
+// with a single query.
+const exportBatchSize = 100
+
+// ExportFunc receives an exported company together with all its services.
+type ExportFunc func(company *Company, services []Service) error
+
+// ExportCompanies streams companies matching filter from a cursor, oldest
+// first, and calls fn for each of them. Services are fetched for batches of
+// companies. It stops at the first error returned by fn.
+func ExportCompanies(
+	ctx context.Context,
+	db *mongo.Database,
+	filter CompanyFilter,
+	fn ExportFunc,
+) error {
+	query, err := companyFilter(ctx, db, &filter)
+	if err != nil {
+		return err
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetBatchSize(exportBatchSize)
+	coll := db.Collection(database.CollName)
+	cursor, err := coll.Find(ctx, bson.M{"$and": query}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var batch []Company
+	for cursor.Next(ctx) {
+		var company Company
+		if err := cursor.Decode(&company); err != nil {
+			return err
+		}
+		batch = append(batch, company)
+		if len(batch) == exportBatchSize {
+			if err := exportBatch(ctx, db, batch, fn); err != nil {
+				return err
+			}
+			batch = nil
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	return exportBatch(ctx, db, batch, fn)
+}
+
+func exportBatch(
+	ctx context.Context,
+	db *mongo.Database,
+	companies []Company,
+	fn ExportFunc,
+) error {
+	if len(companies) == 0 {
+		return nil
+	}
+	var companyIDs []primitive.ObjectID
+	for idx := range companies {
+		companyIDs = append(companyIDs, companies[idx].ID)
+	}
+	opts := options.Find().SetSort(bson.D{
+		{Key: "company_id", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+	coll := db.Collection(database.ServicesCollName)
+	cursor, err := coll.Find(ctx, bson.M{"company_id": bson.M{"$in": companyIDs}}, opts)
+	if err != nil {
+		return err
+	}
+	var services []Service
+	if err := cursor.All(ctx, &services); err != nil {
+		return err
+	}
+	byCompany := make(map[primitive.ObjectID][]Service, len(companies))
+	for _, service := range services {
+		byCompany[service.CompanyID] = append(byCompany[service.CompanyID], service)
+	}
+	for idx := range companies {
+		if err := fn(&companies[idx], byCompany[companies[idx].ID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
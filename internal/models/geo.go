@@ -0,0 +1,94 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// EarthRadius in meters, the same which MongoDB uses for spherical
+// geometry.
+const EarthRadius = 6378100.0
+
+// GeoPoint is a GeoJSON point. Coordinates are longitude and latitude in
+// that order.
+type GeoPoint struct {
+	Type        string     `bson:"type"`
+	Coordinates [2]float64 `bson:"coordinates"`
+}
+
+func NewGeoPoint(latitude float64, longitude float64) *GeoPoint {
+	return &GeoPoint{
+		Type:        "Point",
+		Coordinates: [2]float64{longitude, latitude},
+	}
+}
+
+func (point *GeoPoint) Latitude() float64 {
+	return point.Coordinates[1]
+}
+
+func (point *GeoPoint) Longitude() float64 {
+	return point.Coordinates[0]
+}
+
+func (point *GeoPoint) String() string {
+	return fmt.Sprintf("%g,%g", point.Latitude(), point.Longitude())
+}
+
+// Distance returns great-circle distance between points in meters.
+func (point *GeoPoint) Distance(other *GeoPoint) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	lat1, lat2 := toRadians(point.Latitude()), toRadians(other.Latitude())
+	dLat := lat2 - lat1
+	dLng := toRadians(other.Longitude() - point.Longitude())
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// FindCompaniesNear finds companies within radius meters from point,
+// nearest first, with Distance set. Pagination starts after company with
+// startDistance and startValue, when startValue is set.
+func FindCompaniesNear(
+	ctx context.Context,
+	db *mongo.Database,
+	point *GeoPoint,
+	radius float64,
+	startDistance float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) (*mongo.Cursor, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.M{
+			"near":          point,
+			"distanceField": "distance",
+			"maxDistance":   radius,
+			"query":         notDeleted,
+			"spherical":     true,
+		}}},
+	}
+	if !startValue.IsZero() {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"distance": bson.M{"$gt": startDistance}},
+			bson.M{"distance": startDistance, "_id": bson.M{"$lt": startValue}},
+		}}}})
+	}
+	pipeline = append(
+		pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "distance", Value: 1},
+			{Key: "_id", Value: -1},
+		}}},
+		bson.D{{Key: "$limit", Value: nPerPage}},
+		bson.D{{Key: "$project", Value: bson.M{"long_description": 0}}},
+	)
+	coll := db.Collection(database.CollName)
+	return coll.Aggregate(ctx, pipeline)
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// MinutesPerDay is the exclusive upper bound of Interval.End.
+const MinutesPerDay = 24 * 60
+
+// DateLayout is the format of dates of special days.
+const DateLayout = "2006-01-02"
+
+// Interval of a day in minutes since midnight, Start is inclusive and End
+// exclusive.
+type Interval struct {
+	Start int32 `bson:"start"`
+	End   int32 `bson:"end"`
+}
+
+func (interval Interval) String() string {
+	return fmt.Sprintf(
+		"%02d:%02d-%02d:%02d",
+		interval.Start/60, interval.Start%60,
+		interval.End/60, interval.End%60,
+	)
+}
+
+// SpecialDay replaces weekly hours on its date, no intervals mean that the
+// company is closed for the whole day.
+type SpecialDay struct {
+	// Date in DateLayout format, in the company time zone.
+	Date      string     `bson:"date"`
+	Intervals []Interval `bson:"intervals"`
+}
+
+// OpeningHours of a company. All times are in the company TimeZone.
+type OpeningHours struct {
+	// TimeZone is an IANA time zone name, for example Europe/Warsaw.
+	TimeZone string `bson:"time_zone"`
+	// Weekly holds intervals of each day of week, indexed by time.Weekday.
+	Weekly      [7][]Interval `bson:"weekly"`
+	SpecialDays []SpecialDay  `bson:"special_days,omitempty"`
+}
+
+// String formats hours for the audit log.
+func (hours *OpeningHours) String() string {
+	if hours == nil {
+		return ""
+	}
+	parts := []string{hours.TimeZone}
+	formatDay := func(name string, intervals []Interval) {
+		if len(intervals) == 0 {
+			parts = append(parts, name+" closed")
+			return
+		}
+		var formatted []string
+		for _, interval := range intervals {
+			formatted = append(formatted, interval.String())
+		}
+		parts = append(parts, name+" "+strings.Join(formatted, ","))
+	}
+	for day := time.Monday; day <= time.Saturday; day++ {
+		formatDay(day.String()[:3], hours.Weekly[day])
+	}
+	formatDay(time.Sunday.String()[:3], hours.Weekly[time.Sunday])
+	for _, specialDay := range hours.SpecialDays {
+		formatDay(specialDay.Date, specialDay.Intervals)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Location loads the time zone of the hours.
+func (hours *OpeningHours) Location() (*time.Location, error) {
+	return time.LoadLocation(hours.TimeZone)
+}
+
+// Intervals returns opening hours on the date of day, special days take
+// precedence over weekly hours. day should be in the hours time zone.
+func (hours *OpeningHours) Intervals(day time.Time) []Interval {
+	date := day.Format(DateLayout)
+	for _, specialDay := range hours.SpecialDays {
+		if specialDay.Date == date {
+			return specialDay.Intervals
+		}
+	}
+	return hours.Weekly[day.Weekday()]
+}
+
+// IsOpenAt tells whether t falls into one of intervals of its day in the
+// hours time zone.
+func (hours *OpeningHours) IsOpenAt(t time.Time) (bool, error) {
+	location, err := hours.Location()
+	if err != nil {
+		return false, err
+	}
+	t = t.In(location)
+	minute := int32(t.Hour()*60 + t.Minute())
+	for _, interval := range hours.Intervals(t) {
+		if interval.Start <= minute && minute < interval.End {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SetOpeningHours replaces opening hours of the company, nil hours remove
+// them. Company version is incremented like in CompanyUpdate.UpdateOne.
+func SetOpeningHours(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	hours *OpeningHours,
+	version *int64,
+) (*mongo.UpdateResult, error) {
+	coll := db.Collection(database.CollName)
+	filter := bson.A{bson.M{"_id": companyID}, notDeleted}
+	if version != nil {
+		filter = append(filter, bson.M{"version": *version})
+	}
+	update := bson.M{"$inc": bson.M{"version": 1}}
+	if hours != nil {
+		update["$set"] = bson.M{"opening_hours": hours}
+	} else {
+		update["$unset"] = bson.M{"opening_hours": ""}
+	}
+	return coll.UpdateOne(ctx, bson.M{"$and": filter}, update)
+}
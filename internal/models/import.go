@@ -0,0 +1,207 @@
+package models
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// ErrCompanyDeleted rejects import of a company whose name is taken by a
+// soft deleted company, it has to be restored or purged first.
+var ErrCompanyDeleted = errors.New("company with that name is deleted")
+
+// CompanyImport is a company with its services. Company is upserted by
+// name with fields set by Apply replaced and services are upserted by name
+// within the company, other services of the company are left untouched.
+type CompanyImport struct {
+	Company  Company
+	Services []Service
+}
+
+// Apply copies imported fields to the company, other fields like opening
+// hours are kept.
+func (companyImport *CompanyImport) Apply(company *Company) {
+	imported := &companyImport.Company
+	company.Name = imported.Name
+	company.Type = imported.Type
+	company.Localisation = imported.Localisation
+	company.ShortDescription = imported.ShortDescription
+	company.LongDescription = imported.LongDescription
+	company.Location = imported.Location
+	company.Currency = imported.Currency
+}
+
+// ImportResult tells what happened to the imported company.
+type ImportResult struct {
+	// Err is set when company was rejected, other fields are zero then.
+	Err       error
+	CompanyID primitive.ObjectID
+	// Before is nil for created companies.
+	Before   *Company
+	Services []ServiceImportResult
+}
+
+type ServiceImportResult struct {
+	ServiceID primitive.ObjectID
+	// Before is nil for created services.
+	Before *Service
+}
+
+// ImportCompanies upserts companies and then their services, each with a
+// single BulkWrite. Names of imported companies should be unique.
+func ImportCompanies(
+	ctx context.Context,
+	db *mongo.Database,
+	imports []CompanyImport,
+) ([]ImportResult, error) {
+	results := make([]ImportResult, len(imports))
+	var names []string
+	for idx := range imports {
+		names = append(names, imports[idx].Company.Name)
+	}
+	coll := db.Collection(database.CollName)
+	cursor, err := coll.Find(ctx, bson.M{"name": bson.M{"$in": names}})
+	if err != nil {
+		return nil, err
+	}
+	var existing []Company
+	if err := cursor.All(ctx, &existing); err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*Company, len(existing))
+	for idx := range existing {
+		byName[existing[idx].Name] = &existing[idx]
+	}
+
+	var writes []mongo.WriteModel
+	// written holds index of the import of each write.
+	var written []int
+	for idx := range imports {
+		company := &imports[idx].Company
+		if before, ok := byName[company.Name]; ok {
+			if before.DeletedAt != nil {
+				results[idx].Err = ErrCompanyDeleted
+				continue
+			}
+			results[idx].CompanyID = before.ID
+			results[idx].Before = before
+		}
+		set := bson.M{
+			"type":              company.Type,
+			"localisation":      company.Localisation,
+			"short_description": company.ShortDescription,
+			"long_description":  company.LongDescription,
+		}
+		unset := bson.M{}
+		update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+		if company.Location != nil {
+			set["location"] = company.Location
+		} else {
+			unset["location"] = ""
+		}
+		if company.Currency != "" {
+			set["currency"] = company.Currency
+		} else {
+			unset["currency"] = ""
+		}
+		if len(unset) != 0 {
+			update["$unset"] = unset
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"name": company.Name}).
+			SetUpdate(update).
+			SetUpsert(true))
+		written = append(written, idx)
+	}
+	if len(writes) == 0 {
+		return results, nil
+	}
+	bulkResult, err := coll.BulkWrite(ctx, writes)
+	if err != nil {
+		return nil, err
+	}
+	for writeIdx, id := range bulkResult.UpsertedIDs {
+		results[written[writeIdx]].CompanyID = id.(primitive.ObjectID)
+	}
+	return results, importServices(ctx, db, imports, results)
+}
+
+func importServices(
+	ctx context.Context,
+	db *mongo.Database,
+	imports []CompanyImport,
+	results []ImportResult,
+) error {
+	var companyIDs []primitive.ObjectID
+	for idx := range imports {
+		if results[idx].Err == nil && len(imports[idx].Services) != 0 {
+			companyIDs = append(companyIDs, results[idx].CompanyID)
+		}
+	}
+	if len(companyIDs) == 0 {
+		return nil
+	}
+	coll := db.Collection(database.ServicesCollName)
+	cursor, err := coll.Find(ctx, bson.M{"company_id": bson.M{"$in": companyIDs}})
+	if err != nil {
+		return err
+	}
+	var existing []Service
+	if err := cursor.All(ctx, &existing); err != nil {
+		return err
+	}
+	type serviceKey struct {
+		companyID primitive.ObjectID
+		name      string
+	}
+	byKey := make(map[serviceKey]*Service, len(existing))
+	for idx := range existing {
+		byKey[serviceKey{existing[idx].CompanyID, existing[idx].Name}] = &existing[idx]
+	}
+
+	var writes []mongo.WriteModel
+	var written []*ServiceImportResult
+	for idx := range imports {
+		if results[idx].Err != nil {
+			continue
+		}
+		companyID := results[idx].CompanyID
+		results[idx].Services = make([]ServiceImportResult, len(imports[idx].Services))
+		for serviceIdx := range imports[idx].Services {
+			service := &imports[idx].Services[serviceIdx]
+			result := &results[idx].Services[serviceIdx]
+			if before, ok := byKey[serviceKey{companyID, service.Name}]; ok {
+				result.ServiceID = before.ID
+				result.Before = before
+			}
+			update := bson.M{
+				"$set": bson.M{
+					"price":       service.Price,
+					"duration":    service.Duration,
+					"description": service.Description,
+					"variants":    service.Variants,
+					"add_ons":     service.AddOns,
+				},
+				"$inc": bson.M{"version": 1},
+			}
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"company_id": companyID, "name": service.Name}).
+				SetUpdate(update).
+				SetUpsert(true))
+			written = append(written, result)
+		}
+	}
+	bulkResult, err := coll.BulkWrite(ctx, writes)
+	if err != nil {
+		return err
+	}
+	for writeIdx, id := range bulkResult.UpsertedIDs {
+		written[writeIdx].ServiceID = id.(primitive.ObjectID)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+type CategoryRepository struct {
+	store *Store
+}
+
+func (r *CategoryRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	category *models.Category,
+) (primitive.ObjectID, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return primitive.NilObjectID, models.ErrNotFound
+	}
+	if s.categoryNameTaken(companyID, category.Name, primitive.NilObjectID) {
+		return primitive.NilObjectID, models.ErrDuplicate
+	}
+	newCategory := *category
+	newCategory.ID = primitive.NewObjectID()
+	newCategory.CompanyID = companyID
+	newCategory.Version = 1
+	s.categories[companyID] = append(s.categories[companyID], newCategory)
+	return newCategory.ID, nil
+}
+
+// categoryNameTaken emulates the unique index on names of categories of
+// the company.
+func (s *Store) categoryNameTaken(companyID primitive.ObjectID, name string, except primitive.ObjectID) bool {
+	for _, category := range s.categories[companyID] {
+		if category.ID != except && category.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findCategory returns index of the category or -1 when company or
+// category does not exist.
+func (s *Store) findCategory(companyID, categoryID primitive.ObjectID) int {
+	if _, ok := s.liveCompany(companyID); !ok {
+		return -1
+	}
+	for idx, category := range s.categories[companyID] {
+		if category.ID == categoryID {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (r *CategoryRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	categoryUpdate *models.CategoryUpdate,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findCategory(companyID, categoryID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	category := &s.categories[companyID][idx]
+	if version != nil && *version != category.Version {
+		return models.ErrVersionMismatch
+	}
+	if categoryUpdate.Name != nil &&
+		s.categoryNameTaken(companyID, *categoryUpdate.Name, categoryID) {
+		return models.ErrDuplicate
+	}
+	categoryUpdate.Apply(category)
+	category.Version++
+	return nil
+}
+
+func (r *CategoryRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findCategory(companyID, categoryID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	categories := s.categories[companyID]
+	if version != nil && *version != categories[idx].Version {
+		return models.ErrVersionMismatch
+	}
+	s.categories[companyID] = append(categories[:idx:idx], categories[idx+1:]...)
+	services := s.services[companyID]
+	for serviceIdx := range services {
+		if services[serviceIdx].CategoryID == categoryID {
+			services[serviceIdx].CategoryID = primitive.NilObjectID
+			services[serviceIdx].Version++
+		}
+	}
+	return nil
+}
+
+func (r *CategoryRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+) (*models.Category, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.findCategory(companyID, categoryID)
+	if idx == -1 {
+		return nil, models.ErrNotFound
+	}
+	category := s.categories[companyID][idx]
+	return &category, nil
+}
+
+func (r *CategoryRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) ([]models.Category, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return nil, models.ErrNotFound
+	}
+	categories := append([]models.Category(nil), s.categories[companyID]...)
+	// Categories are in insertion order, stable sort keeps the oldest first
+	// among equal positions.
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].Position < categories[j].Position
+	})
+	return categories, nil
+}
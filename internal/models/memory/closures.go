@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+type ClosureRepository struct {
+	store *Store
+}
+
+func (r *ClosureRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closure *models.Closure,
+) (primitive.ObjectID, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return primitive.NilObjectID, models.ErrNotFound
+	}
+	newClosure := *closure
+	newClosure.ID = primitive.NewObjectID()
+	newClosure.CompanyID = companyID
+	s.closures[companyID] = append(s.closures[companyID], newClosure)
+	return newClosure.ID, nil
+}
+
+// findClosure returns index of the closure or -1 when company or closure
+// does not exist.
+func (s *Store) findClosure(companyID, closureID primitive.ObjectID) int {
+	if _, ok := s.liveCompany(companyID); !ok {
+		return -1
+	}
+	for idx, closure := range s.closures[companyID] {
+		if closure.ID == closureID {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (r *ClosureRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findClosure(companyID, closureID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	closures := s.closures[companyID]
+	s.closures[companyID] = append(closures[:idx:idx], closures[idx+1:]...)
+	return nil
+}
+
+func (r *ClosureRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) (*models.Closure, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.findClosure(companyID, closureID)
+	if idx == -1 {
+		return nil, models.ErrNotFound
+	}
+	closure := s.closures[companyID][idx]
+	return &closure, nil
+}
+
+func (r *ClosureRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	startDate string,
+	endDate string,
+) ([]models.Closure, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return nil, models.ErrNotFound
+	}
+	var closures []models.Closure
+	for _, closure := range s.closures[companyID] {
+		if startDate != "" && closure.EndDate < startDate {
+			continue
+		}
+		if endDate != "" && closure.StartDate > endDate {
+			continue
+		}
+		closures = append(closures, closure)
+	}
+	sort.Slice(closures, func(i, j int) bool {
+		if closures[i].StartDate != closures[j].StartDate {
+			return closures[i].StartDate < closures[j].StartDate
+		}
+		return bytes.Compare(closures[i].ID[:], closures[j].ID[:]) < 0
+	})
+	return closures, nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// Export copies matching companies and their services first, so fn is
+// called without holding the lock.
+func (r *CompanyRepository) Export(
+	ctx context.Context,
+	filter models.CompanyFilter,
+	fn models.ExportFunc,
+) error {
+	s := r.store
+	s.mu.RLock()
+	ids := s.sortedCompanyIDs(
+		models.Sort{Ascending: true},
+		models.Cursor{},
+		s.matching(filter),
+	)
+	companies := make([]models.Company, 0, len(ids))
+	services := make([][]models.Service, 0, len(ids))
+	for _, id := range ids {
+		companies = append(companies, s.companies[id])
+		companyServices := append([]models.Service(nil), s.services[id]...)
+		sort.Slice(companyServices, func(i, j int) bool {
+			return idLess(companyServices[i].ID, companyServices[j].ID)
+		})
+		services = append(services, companyServices)
+	}
+	s.mu.RUnlock()
+
+	for idx := range companies {
+		if err := fn(&companies[idx], services[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
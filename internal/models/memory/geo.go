@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+func (r *CompanyRepository) FindNear(
+	ctx context.Context,
+	point *models.GeoPoint,
+	radius float64,
+	startDistance float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]models.Company, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var companies []models.Company
+	for _, company := range s.companies {
+		if company.DeletedAt != nil || company.Location == nil {
+			continue
+		}
+		company.Distance = point.Distance(company.Location)
+		if company.Distance > radius {
+			continue
+		}
+		if !startValue.IsZero() {
+			after := company.Distance > startDistance ||
+				(company.Distance == startDistance && idLess(company.ID, startValue))
+			if !after {
+				continue
+			}
+		}
+		companies = append(companies, short(company))
+	}
+	sort.Slice(companies, func(i, j int) bool {
+		if companies[i].Distance != companies[j].Distance {
+			return companies[i].Distance < companies[j].Distance
+		}
+		return idLess(companies[j].ID, companies[i].ID)
+	})
+	if nPerPage > 0 && int64(len(companies)) > nPerPage {
+		companies = companies[:nPerPage]
+	}
+	return companies, nil
+}
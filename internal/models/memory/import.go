@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+func (r *CompanyRepository) Import(
+	ctx context.Context,
+	imports []models.CompanyImport,
+) ([]models.ImportResult, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results := make([]models.ImportResult, len(imports))
+	for idx := range imports {
+		company := imports[idx].Company
+		result := &results[idx]
+		if before, ok := s.companyByName(company.Name); ok {
+			if before.DeletedAt != nil {
+				result.Err = models.ErrCompanyDeleted
+				continue
+			}
+			result.Before = &before
+			company = before
+			imports[idx].Apply(&company)
+			company.Version = before.Version + 1
+		} else {
+			company.ID = primitive.NewObjectID()
+			company.Version = 1
+		}
+		result.CompanyID = company.ID
+		s.companies[company.ID] = company
+		for _, service := range imports[idx].Services {
+			result.Services = append(
+				result.Services,
+				s.importService(company.ID, service),
+			)
+		}
+	}
+	return results, nil
+}
+
+func (s *Store) companyByName(name string) (models.Company, bool) {
+	for _, company := range s.companies {
+		if company.Name == name {
+			return company, true
+		}
+	}
+	return models.Company{}, false
+}
+
+func (s *Store) importService(
+	companyID primitive.ObjectID,
+	service models.Service,
+) models.ServiceImportResult {
+	service.CompanyID = companyID
+	services := s.services[companyID]
+	for idx := range services {
+		if services[idx].Name == service.Name {
+			before := services[idx]
+			service.ID = before.ID
+			service.StaffIDs = before.StaffIDs
+			service.CategoryID = before.CategoryID
+			service.Version = before.Version + 1
+			services[idx] = service
+			return models.ServiceImportResult{ServiceID: service.ID, Before: &before}
+		}
+	}
+	service.ID = primitive.NewObjectID()
+	service.Version = 1
+	s.services[companyID] = append(services, service)
+	return models.ServiceImportResult{ServiceID: service.ID}
+}
@@ -0,0 +1,823 @@
+// Package memory implements the repositories from the models package in
+// process memory. It mirrors the behaviour of the MongoDB implementation
+// and is meant for tests and local development without a database.
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// Store holds all companies and their services. Repositories returned by
+// its methods share the same data.
+type Store struct {
+	mu sync.RWMutex
+	// txMu serializes transactions.
+	txMu      sync.Mutex
+	companies map[primitive.ObjectID]models.Company
+	// services are kept in insertion order.
+	services map[primitive.ObjectID][]models.Service
+	closures map[primitive.ObjectID][]models.Closure
+	staff    map[primitive.ObjectID][]models.Staff
+	// categories are kept in insertion order.
+	categories map[primitive.ObjectID][]models.Category
+	// auditEvents and outbox are kept in insertion order.
+	auditEvents []models.AuditEvent
+	outbox      []models.OutboxEvent
+}
+
+func NewStore() *Store {
+	return &Store{
+		companies:  make(map[primitive.ObjectID]models.Company),
+		services:   make(map[primitive.ObjectID][]models.Service),
+		closures:   make(map[primitive.ObjectID][]models.Closure),
+		staff:      make(map[primitive.ObjectID][]models.Staff),
+		categories: make(map[primitive.ObjectID][]models.Category),
+	}
+}
+
+func (s *Store) Companies() *CompanyRepository {
+	return &CompanyRepository{store: s}
+}
+
+func (s *Store) Services() *ServiceRepository {
+	return &ServiceRepository{store: s}
+}
+
+func (s *Store) Staff() *StaffRepository {
+	return &StaffRepository{store: s}
+}
+
+func (s *Store) Categories() *CategoryRepository {
+	return &CategoryRepository{store: s}
+}
+
+func (s *Store) Closures() *ClosureRepository {
+	return &ClosureRepository{store: s}
+}
+
+func (s *Store) Audit() *AuditRepository {
+	return &AuditRepository{store: s}
+}
+
+func (s *Store) Outbox() *OutboxRepository {
+	return &OutboxRepository{store: s}
+}
+
+// snapshot is a copy of all data of the store.
+type snapshot struct {
+	companies   map[primitive.ObjectID]models.Company
+	services    map[primitive.ObjectID][]models.Service
+	closures    map[primitive.ObjectID][]models.Closure
+	staff       map[primitive.ObjectID][]models.Staff
+	categories  map[primitive.ObjectID][]models.Category
+	auditEvents []models.AuditEvent
+	outbox      []models.OutboxEvent
+}
+
+func (s *Store) snapshot() snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state := snapshot{
+		companies:   make(map[primitive.ObjectID]models.Company, len(s.companies)),
+		services:    make(map[primitive.ObjectID][]models.Service, len(s.services)),
+		closures:    make(map[primitive.ObjectID][]models.Closure, len(s.closures)),
+		staff:       make(map[primitive.ObjectID][]models.Staff, len(s.staff)),
+		categories:  make(map[primitive.ObjectID][]models.Category, len(s.categories)),
+		auditEvents: append([]models.AuditEvent(nil), s.auditEvents...),
+		outbox:      append([]models.OutboxEvent(nil), s.outbox...),
+	}
+	for id, company := range s.companies {
+		state.companies[id] = company
+	}
+	for id, services := range s.services {
+		state.services[id] = append([]models.Service(nil), services...)
+	}
+	for id, closures := range s.closures {
+		state.closures[id] = append([]models.Closure(nil), closures...)
+	}
+	for id, staff := range s.staff {
+		state.staff[id] = append([]models.Staff(nil), staff...)
+	}
+	for id, categories := range s.categories {
+		state.categories[id] = append([]models.Category(nil), categories...)
+	}
+	return state
+}
+
+func (s *Store) restore(state snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.companies = state.companies
+	s.services = state.services
+	s.closures = state.closures
+	s.staff = state.staff
+	s.categories = state.categories
+	s.auditEvents = state.auditEvents
+	s.outbox = state.outbox
+}
+
+// WithTransaction runs fn and restores state of the store from before the
+// call when fn fails. Transactions are serialized, but they are not
+// isolated from writes made outside of them.
+func (s *Store) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	state := s.snapshot()
+	if err := fn(ctx); err != nil {
+		s.restore(state)
+		return err
+	}
+	return nil
+}
+
+// idLess orders ids the same way MongoDB compares ObjectIDs.
+func idLess(a, b primitive.ObjectID) bool {
+	return bytes.Compare(a[:], b[:]) < 0
+}
+
+// compareKeys compares values of sorted fields, which are nil when
+// sorting by creation.
+func compareKeys(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case int32:
+		b := b.(int32)
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+	case int64:
+		b := b.(int64)
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+	case models.Money:
+		b := b.(models.Money)
+		if result := strings.Compare(a.Currency, b.Currency); result != 0 {
+			return result
+		}
+		return compareKeys(a.Amount, b.Amount)
+	}
+	return 0
+}
+
+// precedes reports whether item with aKey and aID comes before item with
+// bKey and bID in the order selected by sort.
+func precedes(
+	sort models.Sort,
+	aKey interface{},
+	aID primitive.ObjectID,
+	bKey interface{},
+	bID primitive.ObjectID,
+) bool {
+	result := compareKeys(aKey, bKey)
+	if result == 0 {
+		result = bytes.Compare(aID[:], bID[:])
+	}
+	if sort.Ascending {
+		return result < 0
+	}
+	return result > 0
+}
+
+// nameTaken emulates the unique index on company name.
+func (s *Store) nameTaken(name string, except primitive.ObjectID) bool {
+	for id, company := range s.companies {
+		if id != except && company.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// liveCompany returns company which exists and was not soft deleted.
+func (s *Store) liveCompany(companyID primitive.ObjectID) (models.Company, bool) {
+	company, ok := s.companies[companyID]
+	if !ok || company.DeletedAt != nil {
+		return models.Company{}, false
+	}
+	return company, true
+}
+
+// sortedCompanyIDs returns ids of not deleted companies accepted by keep
+// in the order selected by companiesSort, starting after cursor if it is
+// set.
+func (s *Store) sortedCompanyIDs(
+	companiesSort models.Sort,
+	cursor models.Cursor,
+	keep func(models.Company) bool,
+) []primitive.ObjectID {
+	var ids []primitive.ObjectID
+	for id, company := range s.companies {
+		key := companiesSort.CompanyKey(&company)
+		if !cursor.ID.IsZero() &&
+			!precedes(companiesSort, cursor.Key, cursor.ID, key, id) {
+			continue
+		}
+		if company.DeletedAt == nil && keep(company) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := s.companies[ids[i]], s.companies[ids[j]]
+		return precedes(
+			companiesSort,
+			companiesSort.CompanyKey(&a), a.ID,
+			companiesSort.CompanyKey(&b), b.ID,
+		)
+	})
+	return ids
+}
+
+var (
+	_ models.CompanyRepository  = (*CompanyRepository)(nil)
+	_ models.ServiceRepository  = (*ServiceRepository)(nil)
+	_ models.StaffRepository    = (*StaffRepository)(nil)
+	_ models.CategoryRepository = (*CategoryRepository)(nil)
+	_ models.ClosureRepository  = (*ClosureRepository)(nil)
+	_ models.AuditRepository    = (*AuditRepository)(nil)
+	_ models.OutboxRepository   = (*OutboxRepository)(nil)
+	_ models.Transactor         = (*Store)(nil)
+)
+
+type CompanyRepository struct {
+	store *Store
+}
+
+func (r *CompanyRepository) InsertOne(
+	ctx context.Context,
+	company *models.Company,
+) (primitive.ObjectID, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameTaken(company.Name, primitive.NilObjectID) {
+		return primitive.NilObjectID, models.ErrDuplicate
+	}
+	newCompany := *company
+	newCompany.ID = primitive.NewObjectID()
+	newCompany.Version = 1
+	s.companies[newCompany.ID] = newCompany
+	return newCompany.ID, nil
+}
+
+func (r *CompanyRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	companyUpdate *models.CompanyUpdate,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	company, ok := s.liveCompany(companyID)
+	if !ok {
+		return models.ErrNotFound
+	}
+	if version != nil && *version != company.Version {
+		return models.ErrVersionMismatch
+	}
+	if companyUpdate.Name != nil && s.nameTaken(*companyUpdate.Name, companyID) {
+		return models.ErrDuplicate
+	}
+	companyUpdate.Apply(&company)
+	company.Version++
+	s.companies[companyID] = company
+	return nil
+}
+
+func (r *CompanyRepository) SetOpeningHours(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	hours *models.OpeningHours,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	company, ok := s.liveCompany(companyID)
+	if !ok {
+		return models.ErrNotFound
+	}
+	if version != nil && *version != company.Version {
+		return models.ErrVersionMismatch
+	}
+	company.OpeningHours = hours
+	company.Version++
+	s.companies[companyID] = company
+	return nil
+}
+
+func (r *CompanyRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	company, ok := s.liveCompany(companyID)
+	if !ok {
+		return models.ErrNotFound
+	}
+	deletedAt := time.Now().UTC()
+	company.DeletedAt = &deletedAt
+	s.companies[companyID] = company
+	return nil
+}
+
+func (r *CompanyRepository) RestoreOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	company, ok := s.companies[companyID]
+	if !ok || company.DeletedAt == nil {
+		return models.ErrNotFound
+	}
+	company.DeletedAt = nil
+	s.companies[companyID] = company
+	return nil
+}
+
+func (r *CompanyRepository) PurgeDeleted(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	for id, company := range s.companies {
+		if company.DeletedAt != nil && company.DeletedAt.Before(before) {
+			delete(s.companies, id)
+			delete(s.services, id)
+			delete(s.closures, id)
+			delete(s.staff, id)
+			delete(s.categories, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (r *CompanyRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) (*models.Company, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	company, ok := s.liveCompany(companyID)
+	if !ok {
+		return nil, models.ErrNotFound
+	}
+	// _id is excluded by the projection in models.FindOneCompany.
+	company.ID = primitive.NilObjectID
+	return &company, nil
+}
+
+// short strips fields excluded by the projection of list queries.
+func short(company models.Company) models.Company {
+	company.LongDescription = ""
+	return company
+}
+
+func (r *CompanyRepository) FindMany(
+	ctx context.Context,
+	filter models.CompanyFilter,
+	companiesSort models.Sort,
+	cursor models.Cursor,
+	nPerPage int64,
+) ([]models.Company, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := s.sortedCompanyIDs(companiesSort, cursor, s.matching(filter))
+	return s.page(ids, nPerPage), nil
+}
+
+// matching returns function which reports whether company matches filter.
+func (s *Store) matching(filter models.CompanyFilter) func(models.Company) bool {
+	return func(company models.Company) bool {
+		if !filter.MatchesCompany(&company) {
+			return false
+		}
+		if !filter.HasServiceFilter() {
+			return true
+		}
+		for idx := range s.services[company.ID] {
+			if filter.MatchesService(&s.services[company.ID][idx]) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// count counts not deleted companies accepted by keep.
+func (s *Store) count(keep func(models.Company) bool) int64 {
+	var count int64
+	for _, company := range s.companies {
+		if company.DeletedAt == nil && keep(company) {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *CompanyRepository) Count(
+	ctx context.Context,
+	filter models.CompanyFilter,
+) (int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.count(s.matching(filter)), nil
+}
+
+func (r *CompanyRepository) CountByIds(
+	ctx context.Context,
+	companyIDs []primitive.ObjectID,
+) (int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	wanted := make(map[primitive.ObjectID]bool, len(companyIDs))
+	for _, id := range companyIDs {
+		wanted[id] = true
+	}
+	return s.count(func(company models.Company) bool {
+		return wanted[company.ID]
+	}), nil
+}
+
+func (r *CompanyRepository) FindManyByIds(
+	ctx context.Context,
+	companyIDs []primitive.ObjectID,
+	companiesSort models.Sort,
+	cursor models.Cursor,
+	nPerPage int64,
+) ([]models.Company, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	wanted := make(map[primitive.ObjectID]bool, len(companyIDs))
+	for _, id := range companyIDs {
+		wanted[id] = true
+	}
+	ids := s.sortedCompanyIDs(companiesSort, cursor, func(company models.Company) bool {
+		return wanted[company.ID]
+	})
+	return s.page(ids, nPerPage), nil
+}
+
+// page returns at most nPerPage companies with given ids. Zero nPerPage
+// means no limit, like in MongoDB.
+func (s *Store) page(ids []primitive.ObjectID, nPerPage int64) []models.Company {
+	if nPerPage > 0 && int64(len(ids)) > nPerPage {
+		ids = ids[:nPerPage]
+	}
+	var companies []models.Company
+	for _, id := range ids {
+		companies = append(companies, short(s.companies[id]))
+	}
+	return companies
+}
+
+type ServiceRepository struct {
+	store *Store
+}
+
+func (r *ServiceRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	service *models.Service,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return models.ErrNotFound
+	}
+	service.ID = primitive.NewObjectID()
+	service.CompanyID = companyID
+	service.Version = 1
+	s.services[companyID] = append(s.services[companyID], *service)
+	return nil
+}
+
+// findService returns index of the service in company services or -1.
+// Services of soft deleted companies are not found.
+func (s *Store) findService(companyID, serviceID primitive.ObjectID) int {
+	if _, ok := s.liveCompany(companyID); !ok {
+		return -1
+	}
+	for idx, service := range s.services[companyID] {
+		if service.ID == serviceID {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (r *ServiceRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	serviceUpdate *models.ServiceUpdate,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findService(companyID, serviceID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	service := &s.services[companyID][idx]
+	if version != nil && *version != service.Version {
+		return models.ErrVersionMismatch
+	}
+	serviceUpdate.Apply(service)
+	service.Version++
+	return nil
+}
+
+func (r *ServiceRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findService(companyID, serviceID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	services := s.services[companyID]
+	if version != nil && *version != services[idx].Version {
+		return models.ErrVersionMismatch
+	}
+	s.services[companyID] = append(services[:idx:idx], services[idx+1:]...)
+	return nil
+}
+
+func (r *ServiceRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+) (*models.Service, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.findService(companyID, serviceID)
+	if idx == -1 {
+		return nil, models.ErrNotFound
+	}
+	service := s.services[companyID][idx]
+	return &service, nil
+}
+
+func (r *ServiceRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	filter models.ServiceFilter,
+	servicesSort models.Sort,
+	cursor models.Cursor,
+	nPerPage int64,
+) ([]models.Service, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return nil, models.ErrNotFound
+	}
+	var services []models.Service
+	for _, service := range s.services[companyID] {
+		if !filter.MatchesService(&service) {
+			continue
+		}
+		key := servicesSort.ServiceKey(&service)
+		if cursor.ID.IsZero() ||
+			precedes(servicesSort, cursor.Key, cursor.ID, key, service.ID) {
+			services = append(services, service)
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return precedes(
+			servicesSort,
+			servicesSort.ServiceKey(&services[i]), services[i].ID,
+			servicesSort.ServiceKey(&services[j]), services[j].ID,
+		)
+	})
+	if nPerPage > 0 && int64(len(services)) > nPerPage {
+		services = services[:nPerPage]
+	}
+	return services, nil
+}
+
+func (r *ServiceRepository) Count(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	filter models.ServiceFilter,
+) (int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return 0, models.ErrNotFound
+	}
+	var count int64
+	for _, service := range s.services[companyID] {
+		if filter.MatchesService(&service) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *ServiceRepository) FindGroups(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	servicesSort models.Sort,
+	nPerPage int64,
+) ([]models.ServiceGroup, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return nil, models.ErrNotFound
+	}
+	services := append([]models.Service(nil), s.services[companyID]...)
+	sort.Slice(services, func(i, j int) bool {
+		return precedes(
+			servicesSort,
+			servicesSort.ServiceKey(&services[i]), services[i].ID,
+			servicesSort.ServiceKey(&services[j]), services[j].ID,
+		)
+	})
+	var groups []models.ServiceGroup
+	byCategory := make(map[primitive.ObjectID]int)
+	for _, service := range services {
+		idx, ok := byCategory[service.CategoryID]
+		if !ok {
+			idx = len(groups)
+			byCategory[service.CategoryID] = idx
+			groups = append(groups, models.ServiceGroup{CategoryID: service.CategoryID})
+		}
+		group := &groups[idx]
+		if int64(len(group.Services)) < nPerPage {
+			group.Services = append(group.Services, service)
+		}
+		group.TotalCount++
+	}
+	return groups, nil
+}
+
+type AuditRepository struct {
+	store *Store
+}
+
+func (r *AuditRepository) InsertOne(
+	ctx context.Context,
+	event *models.AuditEvent,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.ID = primitive.NewObjectID()
+	s.auditEvents = append(s.auditEvents, *event)
+	return nil
+}
+
+func (r *AuditRepository) FindMany(
+	ctx context.Context,
+	filter models.AuditFilter,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]models.AuditEvent, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []models.AuditEvent
+	for idx := len(s.auditEvents) - 1; idx >= 0; idx-- {
+		event := s.auditEvents[idx]
+		if !filter.CompanyID.IsZero() && event.CompanyID != filter.CompanyID {
+			continue
+		}
+		if filter.From != nil && event.CreatedAt.Before(*filter.From) {
+			continue
+		}
+		if filter.To != nil && !event.CreatedAt.Before(*filter.To) {
+			continue
+		}
+		if !startValue.IsZero() && !idLess(event.ID, startValue) {
+			continue
+		}
+		events = append(events, event)
+	}
+	if nPerPage > 0 && int64(len(events)) > nPerPage {
+		events = events[:nPerPage]
+	}
+	return events, nil
+}
+
+type OutboxRepository struct {
+	store *Store
+}
+
+func (r *OutboxRepository) InsertOne(
+	ctx context.Context,
+	event *models.OutboxEvent,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.ID = primitive.NewObjectID()
+	s.outbox = append(s.outbox, *event)
+	return nil
+}
+
+func (r *OutboxRepository) FindUnpublished(
+	ctx context.Context,
+	limit int64,
+) ([]models.OutboxEvent, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []models.OutboxEvent
+	for _, event := range s.outbox {
+		if limit > 0 && int64(len(events)) == limit {
+			break
+		}
+		if event.PublishedAt == nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkPublished(
+	ctx context.Context,
+	eventID primitive.ObjectID,
+	publishedAt time.Time,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for idx := range s.outbox {
+		if s.outbox[idx].ID == eventID {
+			s.outbox[idx].PublishedAt = &publishedAt
+			return nil
+		}
+	}
+	return models.ErrNotFound
+}
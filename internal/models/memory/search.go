@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// textScore approximates MongoDB text search: each occurrence of a query
+// term in a field adds weight of that field. Stemming and stop words are
+// not emulated.
+func textScore(company *models.Company, terms map[string]bool) float64 {
+	fields := map[string]string{
+		"name":              company.Name,
+		"type":              company.Type,
+		"short_description": company.ShortDescription,
+		"long_description":  company.LongDescription,
+	}
+	var score float64
+	for _, weight := range database.TextWeights {
+		for _, word := range words(fields[weight.Key]) {
+			if terms[word] {
+				score += float64(weight.Value.(int))
+			}
+		}
+	}
+	return score
+}
+
+func (r *CompanyRepository) Search(
+	ctx context.Context,
+	query string,
+	startScore float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]models.Company, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	terms := make(map[string]bool)
+	for _, word := range words(query) {
+		terms[word] = true
+	}
+	var companies []models.Company
+	for _, company := range s.companies {
+		if company.DeletedAt != nil {
+			continue
+		}
+		company.Score = textScore(&company, terms)
+		if company.Score == 0 {
+			continue
+		}
+		if !startValue.IsZero() {
+			after := company.Score < startScore ||
+				(company.Score == startScore && idLess(company.ID, startValue))
+			if !after {
+				continue
+			}
+		}
+		companies = append(companies, short(company))
+	}
+	sort.Slice(companies, func(i, j int) bool {
+		if companies[i].Score != companies[j].Score {
+			return companies[i].Score > companies[j].Score
+		}
+		return idLess(companies[j].ID, companies[i].ID)
+	})
+	if nPerPage > 0 && int64(len(companies)) > nPerPage {
+		companies = companies[:nPerPage]
+	}
+	return companies, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+type StaffRepository struct {
+	store *Store
+}
+
+func (r *StaffRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staff *models.Staff,
+) (primitive.ObjectID, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return primitive.NilObjectID, models.ErrNotFound
+	}
+	newStaff := *staff
+	newStaff.ID = primitive.NewObjectID()
+	newStaff.CompanyID = companyID
+	newStaff.Version = 1
+	s.staff[companyID] = append(s.staff[companyID], newStaff)
+	return newStaff.ID, nil
+}
+
+// findStaff returns index of the staff member or -1 when company or staff
+// member does not exist.
+func (s *Store) findStaff(companyID, staffID primitive.ObjectID) int {
+	if _, ok := s.liveCompany(companyID); !ok {
+		return -1
+	}
+	for idx, staff := range s.staff[companyID] {
+		if staff.ID == staffID {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (r *StaffRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	staffUpdate *models.StaffUpdate,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findStaff(companyID, staffID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	staff := &s.staff[companyID][idx]
+	if version != nil && *version != staff.Version {
+		return models.ErrVersionMismatch
+	}
+	staffUpdate.Apply(staff)
+	staff.Version++
+	return nil
+}
+
+func (r *StaffRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	version *int64,
+) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.findStaff(companyID, staffID)
+	if idx == -1 {
+		return models.ErrNotFound
+	}
+	staff := s.staff[companyID]
+	if version != nil && *version != staff[idx].Version {
+		return models.ErrVersionMismatch
+	}
+	s.staff[companyID] = append(staff[:idx:idx], staff[idx+1:]...)
+	services := s.services[companyID]
+	for serviceIdx := range services {
+		service := &services[serviceIdx]
+		var staffIDs []primitive.ObjectID
+		for _, id := range service.StaffIDs {
+			if id != staffID {
+				staffIDs = append(staffIDs, id)
+			}
+		}
+		if len(staffIDs) != len(service.StaffIDs) {
+			service.StaffIDs = staffIDs
+			service.Version++
+		}
+	}
+	return nil
+}
+
+func (r *StaffRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+) (*models.Staff, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.findStaff(companyID, staffID)
+	if idx == -1 {
+		return nil, models.ErrNotFound
+	}
+	staff := s.staff[companyID][idx]
+	return &staff, nil
+}
+
+func (r *StaffRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	activeOnly bool,
+) ([]models.Staff, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveCompany(companyID); !ok {
+		return nil, models.ErrNotFound
+	}
+	var staff []models.Staff
+	for _, member := range s.staff[companyID] {
+		if !activeOnly || member.Active {
+			staff = append(staff, member)
+		}
+	}
+	return staff, nil
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// embeddedService is the layout of services from before they were moved
+// out of company documents into their own collection.
+type embeddedService struct {
+	ID          primitive.ObjectID `bson:"service_id"`
+	Name        string             `bson:"name,omitempty"`
+	Price       int32              `bson:"price,omitempty"`
+	Duration    int32              `bson:"duration,omitempty"`
+	Description string             `bson:"description,omitempty"`
+}
+
+// movedService is the layout of services written by
+// MigrateEmbeddedServices, prices were bare numbers until MigratePrices.
+type movedService struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID   primitive.ObjectID `bson:"company_id,omitempty"`
+	Name        string             `bson:"name,omitempty"`
+	Price       int32              `bson:"price,omitempty"`
+	Duration    int32              `bson:"duration,omitempty"`
+	Description string             `bson:"description,omitempty"`
+	// Version is set by the next migration.
+	Version int64 `bson:"version,omitempty"`
+}
+
+// MigrateEmbeddedServices moves services embedded in company documents to
+// the services collection. It is safe to run it more than once, services
+// which were already copied are skipped. Returns number of migrated
+// companies.
+func MigrateEmbeddedServices(
+	ctx context.Context,
+	db *mongo.Database,
+) (int64, error) {
+	coll := db.Collection(database.CollName)
+	servicesColl := db.Collection(database.ServicesCollName)
+
+	filter := bson.M{"services": bson.M{"$exists": true}}
+	opts := options.Find().SetProjection(bson.M{"services": 1})
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var migrated int64
+	for cursor.Next(ctx) {
+		var company struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			Services []embeddedService  `bson:"services"`
+		}
+		if err := cursor.Decode(&company); err != nil {
+			return migrated, err
+		}
+		var services []any
+		for _, embedded := range company.Services {
+			services = append(services, movedService{
+				ID:          embedded.ID,
+				CompanyID:   company.ID,
+				Name:        embedded.Name,
+				Price:       embedded.Price,
+				Duration:    embedded.Duration,
+				Description: embedded.Description,
+			})
+		}
+		if len(services) != 0 {
+			insertOpts := options.InsertMany().SetOrdered(false)
+			_, err := servicesColl.InsertMany(ctx, services, insertOpts)
+			if err != nil && !isOnlyDuplicateKeyError(err) {
+				return migrated, err
+			}
+		}
+		update := bson.M{"$unset": bson.M{"services": ""}}
+		if _, err := coll.UpdateByID(ctx, company.ID, update); err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, cursor.Err()
+}
+
+// isOnlyDuplicateKeyError reports whether every write error of unordered
+// bulk insert was caused by already existing document.
+func isOnlyDuplicateKeyError(err error) bool {
+	bulkErr, ok := err.(mongo.BulkWriteException)
+	if !ok || bulkErr.WriteConcernError != nil {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if writeErr.Code != 11000 {
+			return false
+		}
+	}
+	return true
+}
+
+// MigratePrices converts bare prices of services, which were amounts in
+// major units, to Money in currency. Services without price get zero
+// price and companies without default currency get currency. It is safe
+// to run it more than once. Returns number of migrated services.
+func MigratePrices(
+	ctx context.Context,
+	db *mongo.Database,
+	currency string,
+) (int64, error) {
+	servicesColl := db.Collection(database.ServicesCollName)
+	filter := bson.M{"$or": bson.A{
+		bson.M{"price": bson.M{"$type": "number"}},
+		bson.M{"price": bson.M{"$exists": false}},
+	}}
+	count, err := servicesColl.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 && currency == "" {
+		return 0, nil
+	}
+	if !IsCurrency(currency) {
+		return 0, fmt.Errorf("invalid currency of legacy prices: %q", currency)
+	}
+	price := bson.M{"$ifNull": bson.A{bson.M{"$toLong": "$price"}, 0}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"price": bson.M{
+		"amount":   bson.M{"$multiply": bson.A{price, MinorUnits(currency)}},
+		"currency": currency,
+	}}}}}
+	result, err := servicesColl.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	coll := db.Collection(database.CollName)
+	_, err = coll.UpdateMany(
+		ctx,
+		bson.M{"currency": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"currency": currency}},
+	)
+	return result.ModifiedCount, err
+}
+
+// MigrateSortedFields stores empty names of companies and services and zero
+// durations of services, which were omitted before. Lists sorted by these
+// fields are paged with range queries, which never match missing fields. It
+// is safe to run it more than once. Returns number of migrated documents.
+func MigrateSortedFields(ctx context.Context, db *mongo.Database) (int64, error) {
+	var migrated int64
+	for _, field := range []struct {
+		collName string
+		name     string
+		value    any
+	}{
+		{database.CollName, "name", ""},
+		{database.ServicesCollName, "name", ""},
+		{database.ServicesCollName, "duration", int32(0)},
+	} {
+		result, err := db.Collection(field.collName).UpdateMany(
+			ctx,
+			bson.M{field.name: bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{field.name: field.value}},
+		)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+	return migrated, nil
+}
@@ -2,37 +2,69 @@ package models
 
 import (
 	"context"
-	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/msik-404/micro-appoint-companies/internal/database"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/exp/slices"
 )
 
 type Service struct {
-	ID          primitive.ObjectID `bson:"service_id,omitempty"`
-	Name        string             `bson:"name,omitempty"`
-	Price       int32              `bson:"price,omitempty"`
-	Duration    int32              `bson:"duration,omitempty"`
-	Description string             `bson:"description,omitempty"`
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID primitive.ObjectID `bson:"company_id,omitempty"`
+	// Name and Duration are stored even when empty, lists sorted by them are
+	// paged with range queries, which never match missing fields.
+	Name        string `bson:"name"`
+	Price       Money  `bson:"price"`
+	Duration    int32  `bson:"duration"`
+	Description string `bson:"description,omitempty"`
+	// StaffIDs are staff members of the company who perform the service.
+	StaffIDs []primitive.ObjectID `bson:"staff_ids,omitempty"`
+	// CategoryID is zero for services without category.
+	CategoryID primitive.ObjectID `bson:"category_id,omitempty"`
+	// Variants and AddOns are priced in the currency of Price.
+	Variants []Variant `bson:"variants,omitempty"`
+	AddOns   []AddOn   `bson:"add_ons,omitempty"`
+	// Version is incremented on every update of the service.
+	Version int64 `bson:"version"`
 }
 
 type Company struct {
-	ID               primitive.ObjectID `bson:"_id,omitempty"`
-	Name             string             `bson:"name,omitempty"`
-	Type             string             `bson:"type,omitempty"`
-	Localisation     string             `bson:"localisation,omitempty"`
-	ShortDescription string             `bson:"short_description,omitempty"`
-	LongDescription  string             `bson:"long_description,omitempty"`
-	Services         []Service          `bson:"services,omitempty"`
+	ID primitive.ObjectID `bson:"_id,omitempty"`
+	// Name is stored even when empty, like names of services.
+	Name             string        `bson:"name"`
+	Type             string        `bson:"type,omitempty"`
+	Localisation     string        `bson:"localisation,omitempty"`
+	ShortDescription string        `bson:"short_description,omitempty"`
+	LongDescription  string        `bson:"long_description,omitempty"`
+	Location         *GeoPoint     `bson:"location,omitempty"`
+	OpeningHours     *OpeningHours `bson:"opening_hours,omitempty"`
+	// Currency is the default currency of prices of the company services.
+	Currency string `bson:"currency,omitempty"`
+	// Version is incremented on every update of the company.
+	Version int64 `bson:"version"`
+	// Score is relevance of the company, only set by SearchCompanies.
+	Score float64 `bson:"score,omitempty"`
+	// Distance in meters, only set by FindCompaniesNear.
+	Distance float64 `bson:"distance,omitempty"`
+	// DeletedAt is set for soft deleted companies, which are hidden from
+	// all queries until restored or purged.
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
 }
 
+// notDeleted filter matches companies which were not soft deleted.
+var notDeleted = bson.M{"deleted_at": bson.M{"$exists": false}}
+
 func (company *Company) InsertOne(
 	ctx context.Context,
 	db *mongo.Database,
 ) (*mongo.InsertOneResult, error) {
+	company.Version = 1
 	coll := db.Collection(database.CollName)
 	return coll.InsertOne(ctx, company)
 }
@@ -43,27 +75,156 @@ type CompanyUpdate struct {
 	Localisation     *string   `bson:"localisation,omitempty"`
 	ShortDescription *string   `bson:"short_description,omitempty"`
 	LongDescription  *string   `bson:"long_description,omitempty"`
-	Services         []Service `bson:"services,omitempty"`
+	Location         *GeoPoint `bson:"location,omitempty"`
+	Currency         *string   `bson:"currency,omitempty"`
 }
 
+// Apply sets fields of the update on the company.
+func (companyUpdate *CompanyUpdate) Apply(company *Company) {
+	if companyUpdate.Name != nil {
+		company.Name = *companyUpdate.Name
+	}
+	if companyUpdate.Type != nil {
+		company.Type = *companyUpdate.Type
+	}
+	if companyUpdate.Localisation != nil {
+		company.Localisation = *companyUpdate.Localisation
+	}
+	if companyUpdate.ShortDescription != nil {
+		company.ShortDescription = *companyUpdate.ShortDescription
+	}
+	if companyUpdate.LongDescription != nil {
+		company.LongDescription = *companyUpdate.LongDescription
+	}
+	if companyUpdate.Location != nil {
+		company.Location = companyUpdate.Location
+	}
+	if companyUpdate.Currency != nil {
+		company.Currency = *companyUpdate.Currency
+	}
+}
+
+// UpdateOne updates the company and increments its version. When version
+// is not nil, company is updated only if its version is equal.
 func (companyUpdate *CompanyUpdate) UpdateOne(
 	ctx context.Context,
 	db *mongo.Database,
 	companyID primitive.ObjectID,
+	version *int64,
 ) (*mongo.UpdateResult, error) {
 	coll := db.Collection(database.CollName)
-	update := bson.M{"$set": companyUpdate}
-	return coll.UpdateByID(ctx, companyID, update)
+	filter := bson.A{bson.M{"_id": companyID}, notDeleted}
+	if version != nil {
+		filter = append(filter, bson.M{"version": *version})
+	}
+	update := bson.M{
+		"$set": companyUpdate,
+		"$inc": bson.M{"version": 1},
+	}
+	return coll.UpdateOne(ctx, bson.M{"$and": filter}, update)
+}
+
+// CompanyExists reports whether company with companyID exists and was not
+// soft deleted.
+func CompanyExists(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (bool, error) {
+	coll := db.Collection(database.CollName)
+	filter := bson.M{"$and": bson.A{bson.M{"_id": companyID}, notDeleted}}
+	count, err := coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	return count != 0, err
 }
 
+// DeleteOneCompany soft deletes company by setting deleted_at. Company and
+// its services are kept until PurgeDeletedCompanies removes them.
 func DeleteOneCompany(
 	ctx context.Context,
 	db *mongo.Database,
 	companyID primitive.ObjectID,
-) (*mongo.DeleteResult, error) {
+) (*mongo.UpdateResult, error) {
 	coll := db.Collection(database.CollName)
-	filter := bson.M{"_id": companyID}
-	return coll.DeleteOne(ctx, filter)
+	filter := bson.M{"$and": bson.A{bson.M{"_id": companyID}, notDeleted}}
+	update := bson.M{"$set": bson.M{"deleted_at": time.Now().UTC()}}
+	return coll.UpdateOne(ctx, filter, update)
+}
+
+// RestoreOneCompany reverts soft delete of the company.
+func RestoreOneCompany(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.UpdateResult, error) {
+	coll := db.Collection(database.CollName)
+	filter := bson.M{
+		"_id":        companyID,
+		"deleted_at": bson.M{"$exists": true},
+	}
+	update := bson.M{"$unset": bson.M{"deleted_at": ""}}
+	return coll.UpdateOne(ctx, filter, update)
+}
+
+// PurgeDeletedCompanies hard deletes companies soft deleted before given
+// time together with their services. Companies are purged in a transaction,
+// RestoreCompany racing with it conflicts with the transaction, so a company
+// is never restored without its services.
+// Returns number of purged companies.
+func PurgeDeletedCompanies(
+	ctx context.Context,
+	db *mongo.Database,
+	before time.Time,
+) (int64, error) {
+	session, err := db.Client().StartSession()
+	if err != nil {
+		return 0, err
+	}
+	defer session.EndSession(ctx)
+	purged, err := session.WithTransaction(
+		ctx,
+		func(ctx mongo.SessionContext) (any, error) {
+			return purgeDeletedCompanies(ctx, db, before)
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return purged.(int64), nil
+}
+
+func purgeDeletedCompanies(
+	ctx context.Context,
+	db *mongo.Database,
+	before time.Time,
+) (int64, error) {
+	coll := db.Collection(database.CollName)
+	filter := bson.M{"deleted_at": bson.M{"$lt": before}}
+	companyIDs, err := coll.Distinct(ctx, "_id", filter)
+	if err != nil || len(companyIDs) == 0 {
+		return 0, err
+	}
+	for _, collName := range []string{
+		database.ServicesCollName,
+		database.ClosuresCollName,
+		database.StaffCollName,
+		database.CategoriesCollName,
+	} {
+		_, err = db.Collection(collName).DeleteMany(
+			ctx,
+			bson.M{"company_id": bson.M{"$in": companyIDs}},
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+	result, err := coll.DeleteMany(ctx, bson.M{"$and": bson.A{
+		filter,
+		bson.M{"_id": bson.M{"$in": companyIDs}},
+	}})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
 }
 
 func FindOneCompany(
@@ -72,171 +233,464 @@ func FindOneCompany(
 	companyID primitive.ObjectID,
 ) *mongo.SingleResult {
 	opts := options.FindOne()
-	opts.SetProjection(bson.D{
-		{Key: "_id", Value: 0},
-		{Key: "services", Value: bson.M{"$slice": 10}},
-	})
+	opts.SetProjection(bson.M{"_id": 0})
 
 	coll := db.Collection(database.CollName)
-	filter := bson.M{"_id": companyID}
+	filter := bson.M{"$and": bson.A{bson.M{"_id": companyID}, notDeleted}}
 	return coll.FindOne(ctx, filter, opts)
 }
 
+// CompanyFilter narrows companies returned by FindManyCompanies. Zero
+// values match everything.
+type CompanyFilter struct {
+	Types      []string
+	NamePrefix string
+	// Company matches if one of its services satisfies all of currency,
+	// price and duration bounds, which are inclusive. Prices are in minor
+	// units of Currency.
+	Currency    string
+	MinPrice    *int64
+	MaxPrice    *int64
+	MaxDuration *int32
+}
+
+// HasServiceFilter reports whether filter has any service bounds.
+func (filter *CompanyFilter) HasServiceFilter() bool {
+	return filter.Currency != "" || filter.MinPrice != nil ||
+		filter.MaxPrice != nil || filter.MaxDuration != nil
+}
+
+// MatchesService reports whether service satisfies service bounds of the
+// filter.
+func (filter *CompanyFilter) MatchesService(service *Service) bool {
+	if filter.Currency != "" && service.Price.Currency != filter.Currency {
+		return false
+	}
+	if filter.MinPrice != nil && service.Price.Amount < *filter.MinPrice {
+		return false
+	}
+	if filter.MaxPrice != nil && service.Price.Amount > *filter.MaxPrice {
+		return false
+	}
+	if filter.MaxDuration != nil && service.Duration > *filter.MaxDuration {
+		return false
+	}
+	return true
+}
+
+// MatchesCompany reports whether company satisfies company fields bounds
+// of the filter.
+func (filter *CompanyFilter) MatchesCompany(company *Company) bool {
+	if len(filter.Types) != 0 && !slices.Contains(filter.Types, company.Type) {
+		return false
+	}
+	return strings.HasPrefix(company.Name, filter.NamePrefix)
+}
+
+// companyFilter translates filter to MongoDB query, it looks up services
+// which match the filter first.
+func companyFilter(
+	ctx context.Context,
+	db *mongo.Database,
+	filter *CompanyFilter,
+) (bson.A, error) {
+	query := bson.A{notDeleted}
+	if len(filter.Types) != 0 {
+		query = append(query, bson.M{"type": bson.M{"$in": filter.Types}})
+	}
+	if filter.NamePrefix != "" {
+		pattern := "^" + regexp.QuoteMeta(filter.NamePrefix)
+		query = append(query, bson.M{"name": primitive.Regex{Pattern: pattern}})
+	}
+	if filter.HasServiceFilter() {
+		servicesFilter := bson.M{}
+		if filter.Currency != "" {
+			servicesFilter["price.currency"] = filter.Currency
+		}
+		price := bson.M{}
+		if filter.MinPrice != nil {
+			price["$gte"] = *filter.MinPrice
+		}
+		if filter.MaxPrice != nil {
+			price["$lte"] = *filter.MaxPrice
+		}
+		if len(price) != 0 {
+			servicesFilter["price.amount"] = price
+		}
+		if filter.MaxDuration != nil {
+			servicesFilter["duration"] = bson.M{"$lte": *filter.MaxDuration}
+		}
+		servicesColl := db.Collection(database.ServicesCollName)
+		companyIDs, err := servicesColl.Distinct(ctx, "company_id", servicesFilter)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, bson.M{"_id": bson.M{"$in": companyIDs}})
+	}
+	return query, nil
+}
+
 func FindManyCompanies(
 	ctx context.Context,
 	db *mongo.Database,
-	startValue primitive.ObjectID,
+	filter CompanyFilter,
+	sort Sort,
+	cursor Cursor,
 	nPerPage int64,
 ) (*mongo.Cursor, error) {
 	opts := options.Find()
-	opts.SetSort(bson.M{"_id": -1})
+	opts.SetSort(sort.bson())
 	opts.SetLimit(nPerPage)
-	opts.SetProjection(bson.D{
-		{Key: "long_description", Value: 0},
-		{Key: "services", Value: 0},
-	})
+	opts.SetProjection(bson.M{"long_description": 0})
 
-	filter := bson.M{}
-	if !startValue.IsZero() {
-		filter = bson.M{"_id": bson.M{"$lt": startValue}}
+	query, err := companyFilter(ctx, db, &filter)
+	if err != nil {
+		return nil, err
+	}
+	if after := sort.after(cursor); after != nil {
+		query = append(query, after)
 	}
 	coll := db.Collection(database.CollName)
-	return coll.Find(ctx, filter, opts)
+	return coll.Find(ctx, bson.M{"$and": query}, opts)
 }
 
 func FindManyCompaniesByIds(
 	ctx context.Context,
 	db *mongo.Database,
-    companyIDS []primitive.ObjectID,
-	startValue primitive.ObjectID,
+	companyIDS []primitive.ObjectID,
+	sort Sort,
+	cursor Cursor,
 	nPerPage int64,
 ) (*mongo.Cursor, error) {
 	opts := options.Find()
-	opts.SetSort(bson.M{"_id": -1})
+	opts.SetSort(sort.bson())
 	opts.SetLimit(nPerPage)
-	opts.SetProjection(bson.D{
-		{Key: "long_description", Value: 0},
-		{Key: "services", Value: 0},
-	})
+	opts.SetProjection(bson.M{"long_description": 0})
 
-    filter := bson.M{"_id": bson.M{"$in": companyIDS}}
-	if !startValue.IsZero() {
-        filter = bson.M{"$and": bson.A{
-            filter,
-            bson.M{"_id": bson.M{"$lt": startValue}},
-        }}
+	filter := bson.M{"$and": bson.A{
+		bson.M{"_id": bson.M{"$in": companyIDS}},
+		notDeleted,
+	}}
+	if after := sort.after(cursor); after != nil {
+		filter = bson.M{"$and": bson.A{filter, after}}
 	}
 	coll := db.Collection(database.CollName)
 	return coll.Find(ctx, filter, opts)
 }
 
-func (service *Service) InsertOne(
+// CountCompanies counts companies matching filter.
+func CountCompanies(
 	ctx context.Context,
 	db *mongo.Database,
-	companyID primitive.ObjectID,
-) (*mongo.UpdateResult, error) {
-	service.ID = primitive.NewObjectID()
+	filter CompanyFilter,
+) (int64, error) {
+	query, err := companyFilter(ctx, db, &filter)
+	if err != nil {
+		return 0, err
+	}
+	coll := db.Collection(database.CollName)
+	return coll.CountDocuments(ctx, bson.M{"$and": query})
+}
 
+// CountCompaniesByIds counts not deleted companies with given ids.
+func CountCompaniesByIds(
+	ctx context.Context,
+	db *mongo.Database,
+	companyIDS []primitive.ObjectID,
+) (int64, error) {
+	filter := bson.M{"$and": bson.A{
+		bson.M{"_id": bson.M{"$in": companyIDS}},
+		notDeleted,
+	}}
 	coll := db.Collection(database.CollName)
-	update := bson.M{"$push": bson.M{"services": service}}
-	return coll.UpdateByID(ctx, companyID, update)
+	return coll.CountDocuments(ctx, filter)
 }
 
-func toBsonRemoveEmpty(value any) (doc *bson.M, err error) {
-	data, err := bson.Marshal(value)
-	if err != nil {
-		return
+// SearchCompanies finds companies matching text query ordered by
+// relevance. Pagination starts after company with startScore and
+// startValue, when startValue is set.
+func SearchCompanies(
+	ctx context.Context,
+	db *mongo.Database,
+	query string,
+	startScore float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) (*mongo.Cursor, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$and": bson.A{
+			bson.M{"$text": bson.M{"$search": query}},
+			notDeleted,
+		}}}},
+		{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
 	}
-	err = bson.Unmarshal(data, &doc)
-	return
+	if !startValue.IsZero() {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"score": bson.M{"$lt": startScore}},
+			bson.M{"score": startScore, "_id": bson.M{"$lt": startValue}},
+		}}}})
+	}
+	pipeline = append(
+		pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "score", Value: -1},
+			{Key: "_id", Value: -1},
+		}}},
+		bson.D{{Key: "$limit", Value: nPerPage}},
+		bson.D{{Key: "$project", Value: bson.M{"long_description": 0}}},
+	)
+	coll := db.Collection(database.CollName)
+	return coll.Aggregate(ctx, pipeline)
 }
 
-func getUpdateTerms(updateMap *bson.M) bson.M {
-	updateTerms := bson.M{}
-	for key, value := range *updateMap {
-		if key != "_id" {
-			key := fmt.Sprintf("services.$.%s", key)
-			updateTerms[key] = value
-		}
-	}
-	return updateTerms
+// InsertOne adds service to the company. Callers should check that
+// company exists with CompanyExists.
+func (service *Service) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.InsertOneResult, error) {
+	service.ID = primitive.NewObjectID()
+	service.CompanyID = companyID
+	service.Version = 1
+
+	coll := db.Collection(database.ServicesCollName)
+	return coll.InsertOne(ctx, service)
 }
 
 type ServiceUpdate struct {
 	Name        *string `bson:"name,omitempty"`
-	Price       *int32  `bson:"price,omitempty"`
+	Price       *Money  `bson:"price,omitempty"`
 	Duration    *int32  `bson:"duration,omitempty"`
 	Description *string `bson:"description,omitempty"`
+	// StaffIDs replace staff of the service when not nil.
+	StaffIDs *[]primitive.ObjectID `bson:"staff_ids,omitempty"`
+	// Variants and AddOns replace the ones of the service when not nil.
+	Variants *[]Variant `bson:"variants,omitempty"`
+	AddOns   *[]AddOn   `bson:"add_ons,omitempty"`
+	// CategoryID replaces category of the service when not nil, zero id
+	// removes the service from its category.
+	CategoryID *primitive.ObjectID `bson:"-"`
+}
+
+// Apply sets fields of the update on the service.
+func (serviceUpdate *ServiceUpdate) Apply(service *Service) {
+	if serviceUpdate.Name != nil {
+		service.Name = *serviceUpdate.Name
+	}
+	if serviceUpdate.Price != nil {
+		service.Price = *serviceUpdate.Price
+	}
+	if serviceUpdate.Duration != nil {
+		service.Duration = *serviceUpdate.Duration
+	}
+	if serviceUpdate.Description != nil {
+		service.Description = *serviceUpdate.Description
+	}
+	if serviceUpdate.StaffIDs != nil {
+		service.StaffIDs = *serviceUpdate.StaffIDs
+	}
+	if serviceUpdate.Variants != nil {
+		service.Variants = *serviceUpdate.Variants
+	}
+	if serviceUpdate.AddOns != nil {
+		service.AddOns = *serviceUpdate.AddOns
+	}
+	if serviceUpdate.CategoryID != nil {
+		service.CategoryID = *serviceUpdate.CategoryID
+	}
+}
+
+// ServiceFilter narrows services returned by FindManyServices. Zero values
+// match everything.
+type ServiceFilter struct {
+	StaffID primitive.ObjectID
+	// CategoryID pointing to zero id matches services without category.
+	CategoryID *primitive.ObjectID
+}
+
+// MatchesService tells whether service passes the filter.
+func (filter *ServiceFilter) MatchesService(service *Service) bool {
+	if filter.CategoryID != nil && *filter.CategoryID != service.CategoryID {
+		return false
+	}
+	if filter.StaffID.IsZero() {
+		return true
+	}
+	for _, staffID := range service.StaffIDs {
+		if staffID == filter.StaffID {
+			return true
+		}
+	}
+	return false
+}
+
+func (filter *ServiceFilter) bson(companyID primitive.ObjectID) bson.M {
+	query := bson.M{"company_id": companyID}
+	if !filter.StaffID.IsZero() {
+		query["staff_ids"] = filter.StaffID
+	}
+	if filter.CategoryID != nil {
+		if filter.CategoryID.IsZero() {
+			query["category_id"] = bson.M{"$exists": false}
+		} else {
+			query["category_id"] = *filter.CategoryID
+		}
+	}
+	return query
+}
+
+// serviceFilter matches service of the company, and if version is not nil
+// only in that version. Staff members and categories are matched the same
+// way.
+func serviceFilter(
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	version *int64,
+) bson.D {
+	filter := bson.D{
+		{Key: "_id", Value: serviceID},
+		{Key: "company_id", Value: companyID},
+	}
+	if version != nil {
+		filter = append(filter, bson.E{Key: "version", Value: *version})
+	}
+	return filter
+}
+
+func FindOneService(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+) *mongo.SingleResult {
+	coll := db.Collection(database.ServicesCollName)
+	return coll.FindOne(ctx, serviceFilter(companyID, serviceID, nil))
 }
 
+// ServiceExists reports whether service with serviceID belongs to the
+// company.
+func ServiceExists(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+) (bool, error) {
+	coll := db.Collection(database.ServicesCollName)
+	filter := serviceFilter(companyID, serviceID, nil)
+	count, err := coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	return count != 0, err
+}
+
+// UpdateOne updates the service and increments its version. When version
+// is not nil, service is updated only if its version is equal.
 func (serviceUpdate *ServiceUpdate) UpdateOne(
 	ctx context.Context,
 	db *mongo.Database,
 	companyID primitive.ObjectID,
 	serviceID primitive.ObjectID,
+	version *int64,
 ) (*mongo.UpdateResult, error) {
-	// this function will erease nil fields,
-	// so that unwanted fields will not be set to empty
-	updateMap, err := toBsonRemoveEmpty(*serviceUpdate)
+	coll := db.Collection(database.ServicesCollName)
+	filter := serviceFilter(companyID, serviceID, version)
+	set := bson.M{}
+	update := bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+	}
+	fields, err := bson.Marshal(serviceUpdate)
 	if err != nil {
 		return nil, err
 	}
-	updateTerms := getUpdateTerms(updateMap)
-
-	coll := db.Collection(database.CollName)
-	filter := bson.D{
-		{Key: "_id", Value: companyID},
-		{Key: "services.service_id", Value: serviceID},
+	if err := bson.Unmarshal(fields, &set); err != nil {
+		return nil, err
+	}
+	if categoryID := serviceUpdate.CategoryID; categoryID != nil {
+		if categoryID.IsZero() {
+			update["$unset"] = bson.M{"category_id": ""}
+		} else {
+			set["category_id"] = *categoryID
+		}
+	}
+	if len(set) == 0 {
+		delete(update, "$set")
 	}
-	update := bson.M{"$set": updateTerms}
 	return coll.UpdateOne(ctx, filter, update)
 }
 
+// DeleteOneService deletes the service. When version is not nil, service
+// is deleted only if its version is equal.
 func DeleteOneService(
 	ctx context.Context,
 	db *mongo.Database,
 	companyID primitive.ObjectID,
 	serviceID primitive.ObjectID,
-) (*mongo.UpdateResult, error) {
-	coll := db.Collection(database.CollName)
-	filter := bson.M{"$and": bson.A{
-		bson.M{"_id": companyID},
-		bson.M{"services.service_id": serviceID},
-	}}
-	update := bson.M{
-		"$pull": bson.M{"services": bson.M{"service_id": serviceID}},
-	}
-	return coll.UpdateOne(ctx, filter, update)
+	version *int64,
+) (*mongo.DeleteResult, error) {
+	coll := db.Collection(database.ServicesCollName)
+	filter := serviceFilter(companyID, serviceID, version)
+	return coll.DeleteOne(ctx, filter)
 }
 
 func FindManyServices(
 	ctx context.Context,
 	db *mongo.Database,
 	companyID primitive.ObjectID,
-	startValue primitive.ObjectID,
+	filter ServiceFilter,
+	sort Sort,
+	cursor Cursor,
 	nPerPage int64,
 ) (*mongo.Cursor, error) {
-	matchStage := bson.D{{Key: "$match", Value: bson.M{"_id": companyID}}}
-	projectionStage := bson.D{{Key: "$project", Value: bson.M{"_id": 0, "services": 1}}}
-	unwindStage := bson.D{{Key: "$unwind", Value: "$services"}}
-	rootStage := bson.D{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$services"}}}
-	sortStage := bson.D{{Key: "$sort", Value: bson.M{"service_id": -1}}}
-	limitStage := bson.D{{Key: "$limit", Value: nPerPage}}
+	opts := options.Find()
+	opts.SetSort(sort.bson())
+	opts.SetLimit(nPerPage)
 
-	pipeline := mongo.Pipeline{
-		matchStage,
-		projectionStage,
-		unwindStage,
-		rootStage,
-		sortStage,
+	query := filter.bson(companyID)
+	if after := sort.after(cursor); after != nil {
+		query = bson.M{"$and": bson.A{query, after}}
 	}
-	if !startValue.IsZero() {
-		startValueStage := bson.D{
-			{Key: "$match", Value: bson.M{"service_id": bson.M{"$lt": startValue}}},
-		}
-        pipeline = append(pipeline, startValueStage)
+	coll := db.Collection(database.ServicesCollName)
+	return coll.Find(ctx, query, opts)
+}
+
+// ServiceGroup is the first page of services of a category.
+type ServiceGroup struct {
+	CategoryID primitive.ObjectID `bson:"_id"`
+	Services   []Service          `bson:"services"`
+	TotalCount int64              `bson:"total_count"`
+}
+
+// FindServiceGroups groups services of the company by category in a single
+// aggregation, see ServiceRepository.FindGroups. $topN needs MongoDB 5.2.
+func FindServiceGroups(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	sort Sort,
+	nPerPage int64,
+) (*mongo.Cursor, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"company_id": companyID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$category_id",
+			"services": bson.M{"$topN": bson.M{
+				"n":      nPerPage,
+				"sortBy": sort.bson(),
+				"output": "$$ROOT",
+			}},
+			"total_count": bson.M{"$sum": 1},
+		}}},
 	}
-    pipeline = append(pipeline, limitStage)
-	coll := db.Collection(database.CollName)
+	coll := db.Collection(database.ServicesCollName)
 	return coll.Aggregate(ctx, pipeline)
 }
+
+func CountServices(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	filter ServiceFilter,
+) (int64, error) {
+	coll := db.Collection(database.ServicesCollName)
+	return coll.CountDocuments(ctx, filter.bson(companyID))
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+
+	"golang.org/x/text/currency"
+)
+
+// Money is an amount in minor units of Currency, for example 1999 with
+// PLN is 19.99 zł.
+type Money struct {
+	Amount int64 `bson:"amount"`
+	// Currency is an ISO 4217 code, for example PLN.
+	Currency string `bson:"currency"`
+}
+
+// String formats money for the audit log, for example 19.99 PLN.
+func (money Money) String() string {
+	scale := CurrencyScale(money.Currency)
+	if scale == 0 {
+		return fmt.Sprintf("%d %s", money.Amount, money.Currency)
+	}
+	sign := ""
+	amount := money.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	factor := MinorUnits(money.Currency)
+	return fmt.Sprintf(
+		"%s%d.%0*d %s",
+		sign, amount/factor, scale, amount%factor, money.Currency,
+	)
+}
+
+// IsCurrency tells whether code is an upper case ISO 4217 currency code.
+func IsCurrency(code string) bool {
+	unit, err := currency.ParseISO(code)
+	return err == nil && unit.String() == code
+}
+
+// CurrencyScale returns number of digits of minor units of the currency,
+// for example 2 for PLN and 0 for JPY.
+func CurrencyScale(code string) int {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 0
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale
+}
+
+// MinorUnits returns number of minor units in a major unit of the
+// currency, for example 100 for PLN.
+func MinorUnits(code string) int64 {
+	factor := int64(1)
+	for idx := 0; idx < CurrencyScale(code); idx++ {
+		factor *= 10
+	}
+	return factor
+}
@@ -0,0 +1,838 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoCompanyRepository implements CompanyRepository on top of the
+// functions in this package.
+type MongoCompanyRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoCompanyRepository) InsertOne(
+	ctx context.Context,
+	company *Company,
+) (primitive.ObjectID, error) {
+	result, err := company.InsertOne(ctx, r.DB)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return primitive.NilObjectID, ErrDuplicate
+		}
+		return primitive.NilObjectID, err
+	}
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *MongoCompanyRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	companyUpdate *CompanyUpdate,
+	version *int64,
+) error {
+	result, err := companyUpdate.UpdateOne(ctx, r.DB, companyID, version)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicate
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingCompany(ctx, companyID, version)
+	}
+	return nil
+}
+
+// missingCompany tells apart company which does not exist from company in
+// other version than expected.
+func (r *MongoCompanyRepository) missingCompany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	version *int64,
+) error {
+	if version == nil {
+		return ErrNotFound
+	}
+	exists, err := CompanyExists(ctx, r.DB, companyID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrNotFound
+}
+
+func (r *MongoCompanyRepository) SetOpeningHours(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	hours *OpeningHours,
+	version *int64,
+) error {
+	result, err := SetOpeningHours(ctx, r.DB, companyID, hours, version)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingCompany(ctx, companyID, version)
+	}
+	return nil
+}
+
+func (r *MongoCompanyRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) error {
+	result, err := DeleteOneCompany(ctx, r.DB, companyID)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoCompanyRepository) RestoreOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) error {
+	result, err := RestoreOneCompany(ctx, r.DB, companyID)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoCompanyRepository) PurgeDeleted(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	return PurgeDeletedCompanies(ctx, r.DB, before)
+}
+
+func (r *MongoCompanyRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) (*Company, error) {
+	var company Company
+	err := FindOneCompany(ctx, r.DB, companyID).Decode(&company)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (r *MongoCompanyRepository) FindMany(
+	ctx context.Context,
+	filter CompanyFilter,
+	sort Sort,
+	cursor Cursor,
+	nPerPage int64,
+) ([]Company, error) {
+	dbCursor, err := FindManyCompanies(ctx, r.DB, filter, sort, cursor, nPerPage)
+	if err != nil {
+		return nil, err
+	}
+	var companies []Company
+	err = dbCursor.All(ctx, &companies)
+	return companies, err
+}
+
+func (r *MongoCompanyRepository) FindManyByIds(
+	ctx context.Context,
+	companyIDs []primitive.ObjectID,
+	sort Sort,
+	cursor Cursor,
+	nPerPage int64,
+) ([]Company, error) {
+	dbCursor, err := FindManyCompaniesByIds(ctx, r.DB, companyIDs, sort, cursor, nPerPage)
+	if err != nil {
+		return nil, err
+	}
+	var companies []Company
+	err = dbCursor.All(ctx, &companies)
+	return companies, err
+}
+
+func (r *MongoCompanyRepository) Count(
+	ctx context.Context,
+	filter CompanyFilter,
+) (int64, error) {
+	return CountCompanies(ctx, r.DB, filter)
+}
+
+func (r *MongoCompanyRepository) CountByIds(
+	ctx context.Context,
+	companyIDs []primitive.ObjectID,
+) (int64, error) {
+	return CountCompaniesByIds(ctx, r.DB, companyIDs)
+}
+
+func (r *MongoCompanyRepository) Export(
+	ctx context.Context,
+	filter CompanyFilter,
+	fn ExportFunc,
+) error {
+	return ExportCompanies(ctx, r.DB, filter, fn)
+}
+
+func (r *MongoCompanyRepository) Import(
+	ctx context.Context,
+	imports []CompanyImport,
+) ([]ImportResult, error) {
+	return ImportCompanies(ctx, r.DB, imports)
+}
+
+func (r *MongoCompanyRepository) Search(
+	ctx context.Context,
+	query string,
+	startScore float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]Company, error) {
+	cursor, err := SearchCompanies(ctx, r.DB, query, startScore, startValue, nPerPage)
+	if err != nil {
+		return nil, err
+	}
+	var companies []Company
+	err = cursor.All(ctx, &companies)
+	return companies, err
+}
+
+func (r *MongoCompanyRepository) FindNear(
+	ctx context.Context,
+	point *GeoPoint,
+	radius float64,
+	startDistance float64,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]Company, error) {
+	cursor, err := FindCompaniesNear(
+		ctx,
+		r.DB,
+		point,
+		radius,
+		startDistance,
+		startValue,
+		nPerPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var companies []Company
+	err = cursor.All(ctx, &companies)
+	return companies, err
+}
+
+// MongoServiceRepository implements ServiceRepository on top of the
+// functions in this package.
+type MongoServiceRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoServiceRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	service *Service,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	_, err := service.InsertOne(ctx, r.DB, companyID)
+	return err
+}
+
+// checkCompany returns ErrNotFound when company does not exist or was
+// soft deleted, services and closures of such company are not accessible.
+func checkCompany(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) error {
+	exists, err := CompanyExists(ctx, db, companyID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoServiceRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	serviceUpdate *ServiceUpdate,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := serviceUpdate.UpdateOne(ctx, r.DB, companyID, serviceID, version)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingService(ctx, companyID, serviceID, version)
+	}
+	return nil
+}
+
+// missingService tells apart service which does not exist from service
+// in other version than expected.
+func (r *MongoServiceRepository) missingService(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	version *int64,
+) error {
+	if version == nil {
+		return ErrNotFound
+	}
+	exists, err := ServiceExists(ctx, r.DB, companyID, serviceID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrNotFound
+}
+
+func (r *MongoServiceRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := DeleteOneService(ctx, r.DB, companyID, serviceID, version)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return r.missingService(ctx, companyID, serviceID, version)
+	}
+	return nil
+}
+
+func (r *MongoServiceRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	serviceID primitive.ObjectID,
+) (*Service, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	var service Service
+	err := FindOneService(ctx, r.DB, companyID, serviceID).Decode(&service)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &service, nil
+}
+
+func (r *MongoServiceRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	filter ServiceFilter,
+	sort Sort,
+	cursor Cursor,
+	nPerPage int64,
+) ([]Service, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	dbCursor, err := FindManyServices(
+		ctx,
+		r.DB,
+		companyID,
+		filter,
+		sort,
+		cursor,
+		nPerPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var services []Service
+	err = dbCursor.All(ctx, &services)
+	return services, err
+}
+
+func (r *MongoServiceRepository) Count(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	filter ServiceFilter,
+) (int64, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return 0, err
+	}
+	return CountServices(ctx, r.DB, companyID, filter)
+}
+
+func (r *MongoServiceRepository) FindGroups(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	sort Sort,
+	nPerPage int64,
+) ([]ServiceGroup, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	cursor, err := FindServiceGroups(ctx, r.DB, companyID, sort, nPerPage)
+	if err != nil {
+		return nil, err
+	}
+	var groups []ServiceGroup
+	err = cursor.All(ctx, &groups)
+	return groups, err
+}
+
+// MongoStaffRepository implements StaffRepository on top of the functions
+// in this package.
+type MongoStaffRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoStaffRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staff *Staff,
+) (primitive.ObjectID, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return primitive.NilObjectID, err
+	}
+	_, err := staff.InsertOne(ctx, r.DB, companyID)
+	return staff.ID, err
+}
+
+// missingStaff tells apart staff member who does not exist from one in
+// other version than expected.
+func (r *MongoStaffRepository) missingStaff(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	version *int64,
+) error {
+	if version == nil {
+		return ErrNotFound
+	}
+	exists, err := StaffExists(ctx, r.DB, companyID, staffID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrNotFound
+}
+
+func (r *MongoStaffRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	staffUpdate *StaffUpdate,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := staffUpdate.UpdateOne(ctx, r.DB, companyID, staffID, version)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingStaff(ctx, companyID, staffID, version)
+	}
+	return nil
+}
+
+func (r *MongoStaffRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := DeleteOneStaff(ctx, r.DB, companyID, staffID, version)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return r.missingStaff(ctx, companyID, staffID, version)
+	}
+	return nil
+}
+
+func (r *MongoStaffRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+) (*Staff, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	var staff Staff
+	err := FindOneStaff(ctx, r.DB, companyID, staffID).Decode(&staff)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &staff, nil
+}
+
+func (r *MongoStaffRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	activeOnly bool,
+) ([]Staff, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	cursor, err := FindManyStaff(ctx, r.DB, companyID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	var staff []Staff
+	err = cursor.All(ctx, &staff)
+	return staff, err
+}
+
+// MongoCategoryRepository implements CategoryRepository on top of the
+// functions in this package.
+type MongoCategoryRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoCategoryRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	category *Category,
+) (primitive.ObjectID, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return primitive.NilObjectID, err
+	}
+	_, err := category.InsertOne(ctx, r.DB, companyID)
+	if mongo.IsDuplicateKeyError(err) {
+		return primitive.NilObjectID, ErrDuplicate
+	}
+	return category.ID, err
+}
+
+// missingCategory tells apart category which does not exist from category
+// in other version than expected.
+func (r *MongoCategoryRepository) missingCategory(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	version *int64,
+) error {
+	if version == nil {
+		return ErrNotFound
+	}
+	exists, err := CategoryExists(ctx, r.DB, companyID, categoryID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrNotFound
+}
+
+func (r *MongoCategoryRepository) UpdateOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	categoryUpdate *CategoryUpdate,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := categoryUpdate.UpdateOne(ctx, r.DB, companyID, categoryID, version)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicate
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingCategory(ctx, companyID, categoryID, version)
+	}
+	return nil
+}
+
+func (r *MongoCategoryRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	version *int64,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := DeleteOneCategory(ctx, r.DB, companyID, categoryID, version)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return r.missingCategory(ctx, companyID, categoryID, version)
+	}
+	return nil
+}
+
+func (r *MongoCategoryRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+) (*Category, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	var category Category
+	err := FindOneCategory(ctx, r.DB, companyID, categoryID).Decode(&category)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *MongoCategoryRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+) ([]Category, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	cursor, err := FindManyCategories(ctx, r.DB, companyID)
+	if err != nil {
+		return nil, err
+	}
+	var categories []Category
+	err = cursor.All(ctx, &categories)
+	return categories, err
+}
+
+// MongoClosureRepository implements ClosureRepository on top of the
+// functions in this package.
+type MongoClosureRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoClosureRepository) InsertOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closure *Closure,
+) (primitive.ObjectID, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return primitive.NilObjectID, err
+	}
+	_, err := closure.InsertOne(ctx, r.DB, companyID)
+	return closure.ID, err
+}
+
+func (r *MongoClosureRepository) DeleteOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) error {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return err
+	}
+	result, err := DeleteOneClosure(ctx, r.DB, companyID, closureID)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoClosureRepository) FindOne(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	closureID primitive.ObjectID,
+) (*Closure, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	var closure Closure
+	err := FindOneClosure(ctx, r.DB, companyID, closureID).Decode(&closure)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &closure, nil
+}
+
+func (r *MongoClosureRepository) FindMany(
+	ctx context.Context,
+	companyID primitive.ObjectID,
+	startDate string,
+	endDate string,
+) ([]Closure, error) {
+	if err := checkCompany(ctx, r.DB, companyID); err != nil {
+		return nil, err
+	}
+	cursor, err := FindManyClosures(ctx, r.DB, companyID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	var closures []Closure
+	err = cursor.All(ctx, &closures)
+	return closures, err
+}
+
+// MongoAuditRepository implements AuditRepository on top of the functions
+// in this package.
+type MongoAuditRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoAuditRepository) InsertOne(
+	ctx context.Context,
+	event *AuditEvent,
+) error {
+	result, err := event.InsertOne(ctx, r.DB)
+	if err != nil {
+		return err
+	}
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoAuditRepository) FindMany(
+	ctx context.Context,
+	filter AuditFilter,
+	startValue primitive.ObjectID,
+	nPerPage int64,
+) ([]AuditEvent, error) {
+	cursor, err := FindManyAuditEvents(ctx, r.DB, filter, startValue, nPerPage)
+	if err != nil {
+		return nil, err
+	}
+	var events []AuditEvent
+	err = cursor.All(ctx, &events)
+	return events, err
+}
+
+// MongoTransactor runs functions in MongoDB transactions, which require
+// MongoDB to run as a replica set.
+type MongoTransactor struct {
+	Client *mongo.Client
+}
+
+// CheckTransactions returns an error when the server is neither a replica
+// set member nor mongos, so it does not support transactions. It is called on
+// startup, writes are never made without a transaction.
+func (t *MongoTransactor) CheckTransactions(ctx context.Context) error {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := t.Client.Database("admin").
+		RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).
+		Decode(&hello)
+	if err != nil {
+		return err
+	}
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		return errors.New("MongoDB should run as a replica set, standalone servers do not support transactions")
+	}
+	return nil
+}
+
+func (t *MongoTransactor) WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	session, err := t.Client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(
+		ctx,
+		func(sessCtx mongo.SessionContext) (any, error) {
+			return nil, fn(sessCtx)
+		},
+	)
+	return err
+}
+
+// MongoOutboxRepository implements OutboxRepository on top of the
+// functions in this package.
+type MongoOutboxRepository struct {
+	DB *mongo.Database
+}
+
+func (r *MongoOutboxRepository) InsertOne(
+	ctx context.Context,
+	event *OutboxEvent,
+) error {
+	result, err := event.InsertOne(ctx, r.DB)
+	if err != nil {
+		return err
+	}
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoOutboxRepository) FindUnpublished(
+	ctx context.Context,
+	limit int64,
+) ([]OutboxEvent, error) {
+	cursor, err := FindUnpublishedEvents(ctx, r.DB, limit)
+	if err != nil {
+		return nil, err
+	}
+	var events []OutboxEvent
+	err = cursor.All(ctx, &events)
+	return events, err
+}
+
+func (r *MongoOutboxRepository) MarkPublished(
+	ctx context.Context,
+	eventID primitive.ObjectID,
+	publishedAt time.Time,
+) error {
+	result, err := MarkEventPublished(ctx, r.DB, eventID, publishedAt)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// connectTestDB connects to a real MongoDB given by MONGO_TEST_URI and
+// returns a database dropped at the end of the test.
+func connectTestDB(t *testing.T, ctx context.Context, name string) *mongo.Database {
+	uri := os.Getenv("MONGO_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_TEST_URI is not set")
+	}
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := client.Database(name)
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+	return db
+}
+
+// TestSortedServicesWithoutName pages services in a real MongoDB, where
+// range queries of cursors do not match missing fields, unlike comparisons
+// of the memory backend. It runs only when MONGO_TEST_URI is set.
+func TestSortedServicesWithoutName(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	db := connectTestDB(t, ctx, "companies_sort_test")
+
+	companies := &MongoCompanyRepository{DB: db}
+	companyID, err := companies.InsertOne(ctx, &Company{Name: "Barber", Currency: "PLN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	services := &MongoServiceRepository{DB: db}
+	for _, service := range []Service{
+		{Name: "Beard", Duration: 15},
+		{},
+		{Name: "Haircut", Duration: 30},
+	} {
+		service.Price = Money{Amount: 100, Currency: "PLN"}
+		if err := services.InsertOne(ctx, companyID, &service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Service stored before names and durations were always written.
+	_, err = db.Collection(database.ServicesCollName).InsertOne(ctx, bson.M{
+		"company_id": companyID,
+		"price":      bson.M{"amount": 100, "currency": "PLN"},
+		"version":    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MigrateSortedFields(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sort := range []Sort{
+		{Field: SortByName, Ascending: true},
+		{Field: SortByName},
+		{Field: SortByDuration, Ascending: true},
+		{Field: SortByDuration},
+	} {
+		var cursor Cursor
+		var seen int
+		for {
+			page, err := services.FindMany(ctx, companyID, ServiceFilter{}, sort, cursor, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			seen++
+			cursor = Cursor{Key: sort.ServiceKey(&page[0]), ID: page[0].ID}
+		}
+		if seen != 4 {
+			t.Fatalf("sorted by %v paged over %d of 4 services", sort, seen)
+		}
+	}
+}
+
+// TestFindServiceGroups runs the grouping aggregation in a real MongoDB.
+func TestFindServiceGroups(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	db := connectTestDB(t, ctx, "companies_groups_test")
+
+	companies := &MongoCompanyRepository{DB: db}
+	companyID, err := companies.InsertOne(ctx, &Company{Name: "Clinic", Currency: "PLN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dental := primitive.NewObjectID()
+	services := &MongoServiceRepository{DB: db}
+	for _, service := range []Service{
+		{Name: "Filling", Duration: 30, CategoryID: dental},
+		{Name: "Whitening", Duration: 60, CategoryID: dental},
+		{Name: "Checkup", Duration: 15},
+	} {
+		service.Price = Money{Amount: 100, Currency: "PLN"}
+		if err := services.InsertOne(ctx, companyID, &service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	groups, err := services.FindGroups(ctx, companyID, Sort{Field: SortByName, Ascending: true}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byCategory := make(map[primitive.ObjectID]ServiceGroup)
+	for _, group := range groups {
+		byCategory[group.CategoryID] = group
+	}
+	dentalGroup := byCategory[dental]
+	if len(groups) != 2 || dentalGroup.TotalCount != 2 ||
+		len(dentalGroup.Services) != 1 || dentalGroup.Services[0].Name != "Filling" {
+		t.Fatalf("unexpected groups: %v", groups)
+	}
+	uncategorized := byCategory[primitive.NilObjectID]
+	if uncategorized.TotalCount != 1 || len(uncategorized.Services) != 1 ||
+		uncategorized.Services[0].Name != "Checkup" {
+		t.Fatalf("unexpected group without category: %v", uncategorized)
+	}
+}
+
+// TestDuplicateCategoryName checks that the unique index on names of
+// categories is mapped to ErrDuplicate.
+func TestDuplicateCategoryName(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	db := connectTestDB(t, ctx, "companies_categories_test")
+	dbName := database.DBName
+	database.DBName = db.Name()
+	defer func() { database.DBName = dbName }()
+	if _, err := database.CreateDBIndexes(db.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	companies := &MongoCompanyRepository{DB: db}
+	companyID, err := companies.InsertOne(ctx, &Company{Name: "Clinic", Currency: "PLN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	categories := &MongoCategoryRepository{DB: db}
+	_, err = categories.InsertOne(ctx, companyID, &Category{Name: "Dental"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	surgery, err := categories.InsertOne(ctx, companyID, &Category{Name: "Surgery"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = categories.InsertOne(ctx, companyID, &Category{Name: "Dental"})
+	if !errors.Is(err, ErrDuplicate) {
+		t.Fatalf("expected ErrDuplicate on insert, got %v", err)
+	}
+	name := "Dental"
+	err = categories.UpdateOne(ctx, companyID, surgery, &CategoryUpdate{Name: &name}, nil)
+	if !errors.Is(err, ErrDuplicate) {
+		t.Fatalf("expected ErrDuplicate on update, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// Types of domain events written to the outbox.
+const (
+	CompanyCreated      = "CompanyCreated"
+	CompanyUpdated      = "CompanyUpdated"
+	CompanyDeleted      = "CompanyDeleted"
+	CompanyRestored     = "CompanyRestored"
+	OpeningHoursChanged = "OpeningHoursChanged"
+	ClosureAdded        = "ClosureAdded"
+	StaffCreated        = "StaffCreated"
+	StaffUpdated        = "StaffUpdated"
+	StaffDeleted        = "StaffDeleted"
+	CategoryCreated     = "CategoryCreated"
+	CategoryUpdated     = "CategoryUpdated"
+	CategoryDeleted     = "CategoryDeleted"
+	ClosureRemoved      = "ClosureRemoved"
+	ServiceCreated      = "ServiceCreated"
+	ServiceUpdated      = "ServiceUpdated"
+	ServicePriceChanged = "ServicePriceChanged"
+	ServiceDeleted      = "ServiceDeleted"
+)
+
+// OutboxEvent is a domain event waiting in the outbox to be published to
+// other services. It is written in the same transaction as the change it
+// describes.
+type OutboxEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Type      string             `bson:"type"`
+	CompanyID primitive.ObjectID `bson:"company_id"`
+	ServiceID primitive.ObjectID `bson:"service_id,omitempty"`
+	// Payload is JSON encoded body of the event.
+	Payload     string     `bson:"payload"`
+	OccurredAt  time.Time  `bson:"occurred_at"`
+	PublishedAt *time.Time `bson:"published_at,omitempty"`
+}
+
+func (event *OutboxEvent) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+) (*mongo.InsertOneResult, error) {
+	coll := db.Collection(database.OutboxCollName)
+	return coll.InsertOne(ctx, event)
+}
+
+// FindUnpublishedEvents returns oldest events which were not published yet.
+func FindUnpublishedEvents(
+	ctx context.Context,
+	db *mongo.Database,
+	limit int64,
+) (*mongo.Cursor, error) {
+	opts := options.Find()
+	opts.SetSort(bson.M{"_id": 1})
+	opts.SetLimit(limit)
+
+	coll := db.Collection(database.OutboxCollName)
+	filter := bson.M{"published_at": bson.M{"$exists": false}}
+	return coll.Find(ctx, filter, opts)
+}
+
+func MarkEventPublished(
+	ctx context.Context,
+	db *mongo.Database,
+	eventID primitive.ObjectID,
+	publishedAt time.Time,
+) (*mongo.UpdateResult, error) {
+	coll := db.Collection(database.OutboxCollName)
+	update := bson.M{"$set": bson.M{"published_at": publishedAt}}
+	return coll.UpdateByID(ctx, eventID, update)
+}
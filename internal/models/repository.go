@@ -0,0 +1,281 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrNotFound is returned by repositories when the targeted document does
+// not exist.
+var ErrNotFound = errors.New("document was not found")
+
+// ErrVersionMismatch is returned by repositories when the document exists
+// but its version differs from the expected one, which means that it was
+// modified concurrently.
+var ErrVersionMismatch = errors.New("document version does not match")
+
+// ErrDuplicate is returned by repositories when a write violates a unique
+// constraint, for example a second company with the same name.
+var ErrDuplicate = errors.New("document with that key already exists")
+
+// CompanyRepository is the storage used by the gRPC server for companies.
+type CompanyRepository interface {
+	InsertOne(ctx context.Context, company *Company) (primitive.ObjectID, error)
+	// UpdateOne updates the company. Version is optional, when it is set
+	// and differs from the stored one ErrVersionMismatch is returned.
+	UpdateOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		companyUpdate *CompanyUpdate,
+		version *int64,
+	) error
+	// SetOpeningHours replaces opening hours of the company, nil hours
+	// remove them. Version works like in UpdateOne.
+	SetOpeningHours(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		hours *OpeningHours,
+		version *int64,
+	) error
+	// DeleteOne soft deletes the company, it can be brought back with
+	// RestoreOne until it is purged.
+	DeleteOne(ctx context.Context, companyID primitive.ObjectID) error
+	RestoreOne(ctx context.Context, companyID primitive.ObjectID) error
+	// PurgeDeleted hard deletes companies soft deleted before given time
+	// and returns their number.
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	FindOne(ctx context.Context, companyID primitive.ObjectID) (*Company, error)
+	// FindMany and FindManyByIds return companies ordered by sort,
+	// starting after cursor. Count and CountByIds return total number of
+	// companies they can return.
+	FindMany(
+		ctx context.Context,
+		filter CompanyFilter,
+		sort Sort,
+		cursor Cursor,
+		nPerPage int64,
+	) ([]Company, error)
+	FindManyByIds(
+		ctx context.Context,
+		companyIDs []primitive.ObjectID,
+		sort Sort,
+		cursor Cursor,
+		nPerPage int64,
+	) ([]Company, error)
+	Count(ctx context.Context, filter CompanyFilter) (int64, error)
+	// Export calls fn for every company matching filter together with all
+	// its services, oldest companies and services first.
+	Export(ctx context.Context, filter CompanyFilter, fn ExportFunc) error
+	// Import upserts companies with their services, results are in the
+	// order of imports.
+	Import(ctx context.Context, imports []CompanyImport) ([]ImportResult, error)
+	CountByIds(ctx context.Context, companyIDs []primitive.ObjectID) (int64, error)
+	// Search returns companies matching text query with Score set, most
+	// relevant first. Next page starts after company with startScore and
+	// startValue, first page is returned for zero startValue.
+	Search(
+		ctx context.Context,
+		query string,
+		startScore float64,
+		startValue primitive.ObjectID,
+		nPerPage int64,
+	) ([]Company, error)
+	// FindNear returns companies within radius meters from point with
+	// Distance set, nearest first. Pagination works like in Search.
+	FindNear(
+		ctx context.Context,
+		point *GeoPoint,
+		radius float64,
+		startDistance float64,
+		startValue primitive.ObjectID,
+		nPerPage int64,
+	) ([]Company, error)
+}
+
+// ServiceRepository is the storage used by the gRPC server for services
+// offered by companies.
+type ServiceRepository interface {
+	InsertOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		service *Service,
+	) error
+	// UpdateOne and DeleteOne accept optional version, same as
+	// CompanyRepository.UpdateOne.
+	UpdateOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		serviceID primitive.ObjectID,
+		serviceUpdate *ServiceUpdate,
+		version *int64,
+	) error
+	DeleteOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		serviceID primitive.ObjectID,
+		version *int64,
+	) error
+	FindOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		serviceID primitive.ObjectID,
+	) (*Service, error)
+	// FindMany returns services matching filter ordered by sort, starting
+	// after cursor. FindMany and Count return ErrNotFound when company does
+	// not exist.
+	FindMany(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		filter ServiceFilter,
+		sort Sort,
+		cursor Cursor,
+		nPerPage int64,
+	) ([]Service, error)
+	Count(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		filter ServiceFilter,
+	) (int64, error)
+	// FindGroups returns first nPerPage services ordered by sort and number
+	// of services in every category of the company, services without
+	// category are grouped under zero id. Categories without services have
+	// no group.
+	FindGroups(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		sort Sort,
+		nPerPage int64,
+	) ([]ServiceGroup, error)
+}
+
+// StaffRepository is the storage of staff members of companies. All methods
+// return ErrNotFound when company does not exist.
+type StaffRepository interface {
+	InsertOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		staff *Staff,
+	) (primitive.ObjectID, error)
+	// UpdateOne accepts optional version, same as
+	// CompanyRepository.UpdateOne.
+	UpdateOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		staffID primitive.ObjectID,
+		staffUpdate *StaffUpdate,
+		version *int64,
+	) error
+	// DeleteOne also removes the staff member from services of the company.
+	DeleteOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		staffID primitive.ObjectID,
+		version *int64,
+	) error
+	FindOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		staffID primitive.ObjectID,
+	) (*Staff, error)
+	// FindMany returns staff of the company, oldest first.
+	FindMany(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		activeOnly bool,
+	) ([]Staff, error)
+}
+
+// CategoryRepository is the storage of categories of services. All methods
+// return ErrNotFound when company does not exist. InsertOne and UpdateOne
+// return ErrDuplicate when other category of the company has the same name.
+type CategoryRepository interface {
+	InsertOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		category *Category,
+	) (primitive.ObjectID, error)
+	// UpdateOne and DeleteOne accept optional version, same as
+	// CompanyRepository.UpdateOne.
+	UpdateOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		categoryID primitive.ObjectID,
+		categoryUpdate *CategoryUpdate,
+		version *int64,
+	) error
+	// DeleteOne leaves services of the category without category.
+	DeleteOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		categoryID primitive.ObjectID,
+		version *int64,
+	) error
+	FindOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		categoryID primitive.ObjectID,
+	) (*Category, error)
+	// FindMany returns categories of the company ordered by position.
+	FindMany(ctx context.Context, companyID primitive.ObjectID) ([]Category, error)
+}
+
+// ClosureRepository is the storage of closures of companies. All methods
+// return ErrNotFound when company does not exist.
+type ClosureRepository interface {
+	InsertOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		closure *Closure,
+	) (primitive.ObjectID, error)
+	DeleteOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		closureID primitive.ObjectID,
+	) error
+	FindOne(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		closureID primitive.ObjectID,
+	) (*Closure, error)
+	// FindMany returns closures overlapping days from startDate to endDate
+	// ordered by start date, empty dates leave the range open.
+	FindMany(
+		ctx context.Context,
+		companyID primitive.ObjectID,
+		startDate string,
+		endDate string,
+	) ([]Closure, error)
+}
+
+// Transactor runs fn in a transaction. Repositories called with context
+// passed to fn take part in that transaction. When fn returns an error all
+// its writes are rolled back and the error is returned.
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// OutboxRepository stores domain events until they are published.
+type OutboxRepository interface {
+	InsertOne(ctx context.Context, event *OutboxEvent) error
+	// FindUnpublished returns at most limit oldest unpublished events.
+	FindUnpublished(ctx context.Context, limit int64) ([]OutboxEvent, error)
+	MarkPublished(
+		ctx context.Context,
+		eventID primitive.ObjectID,
+		publishedAt time.Time,
+	) error
+}
+
+// AuditRepository is append-only storage of audit events.
+type AuditRepository interface {
+	InsertOne(ctx context.Context, event *AuditEvent) error
+	FindMany(
+		ctx context.Context,
+		filter AuditFilter,
+		startValue primitive.ObjectID,
+		nPerPage int64,
+	) ([]AuditEvent, error)
+}
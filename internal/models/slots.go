@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+)
+
+// TimeRange is a half-open range of time, End is exclusive.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (timeRange TimeRange) overlaps(other TimeRange) bool {
+	return timeRange.Start.Before(other.End) && other.Start.Before(timeRange.End)
+}
+
+// SlotOptions configure AvailableSlots.
+type SlotOptions struct {
+	// Duration of the booked service.
+	Duration time.Duration
+	// Step between consecutive candidate starts within an interval.
+	Step time.Duration
+	// BufferBefore and BufferAfter extend the slot when it is checked
+	// against Busy, they do not have to fit in opening hours.
+	BufferBefore time.Duration
+	BufferAfter  time.Duration
+	// Busy ranges, for example existing appointments, no slot overlaps
+	// them.
+	Busy []TimeRange
+}
+
+// AvailableSlots generates slots of days from startDate to endDate in the
+// hours time zone. Slots start at the beginning of each opening interval
+// and every Step after it, as long as the service fits in the interval.
+// Days covered by closures are skipped.
+func AvailableSlots(
+	hours *OpeningHours,
+	closures []Closure,
+	startDate string,
+	endDate string,
+	opts SlotOptions,
+) ([]TimeRange, error) {
+	location, err := hours.Location()
+	if err != nil {
+		return nil, err
+	}
+	first, err := time.ParseInLocation(DateLayout, startDate, location)
+	if err != nil {
+		return nil, err
+	}
+	last, err := time.ParseInLocation(DateLayout, endDate, location)
+	if err != nil {
+		return nil, err
+	}
+	var slots []TimeRange
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if closed(closures, day.Format(DateLayout)) {
+			continue
+		}
+		for _, interval := range hours.Intervals(day) {
+			// time.Date normalizes minutes past the day, which keeps wall
+			// clock times right on days when clocks change.
+			year, month, date := day.Date()
+			end := time.Date(year, month, date, 0, int(interval.End), 0, 0, location)
+			for minute := int(interval.Start); ; minute += int(opts.Step / time.Minute) {
+				start := time.Date(year, month, date, 0, minute, 0, 0, location)
+				slot := TimeRange{Start: start, End: start.Add(opts.Duration)}
+				if slot.End.After(end) {
+					break
+				}
+				if !busy(opts, slot) {
+					slots = append(slots, slot)
+				}
+			}
+		}
+	}
+	return slots, nil
+}
+
+func closed(closures []Closure, date string) bool {
+	for idx := range closures {
+		if closures[idx].Covers(date) {
+			return true
+		}
+	}
+	return false
+}
+
+func busy(opts SlotOptions, slot TimeRange) bool {
+	buffered := TimeRange{
+		Start: slot.Start.Add(-opts.BufferBefore),
+		End:   slot.End.Add(opts.BufferAfter),
+	}
+	for _, busyRange := range opts.Busy {
+		if buffered.overlaps(busyRange) {
+			return true
+		}
+	}
+	return false
+}
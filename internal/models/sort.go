@@ -0,0 +1,113 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Fields by which lists can be sorted. ObjectIDs are generated on insert,
+// so sorting by _id orders documents by creation time.
+const (
+	SortByCreation = "_id"
+	SortByName     = "name"
+	SortByPrice    = "price"
+	SortByDuration = "duration"
+)
+
+// Sort selects order of a list. Ties are broken by _id in the same
+// direction, so the order is total and pagination is stable. Zero value
+// sorts newest first.
+type Sort struct {
+	Field     string
+	Ascending bool
+}
+
+// Cursor points to the last item of the previous page. Key is the value of
+// sorted field of that item, Money when sorting by price, it is ignored when
+// sorting by creation. Zero ID means the first page.
+type Cursor struct {
+	Key interface{}
+	ID  primitive.ObjectID
+}
+
+func (sort Sort) field() string {
+	if sort.Field == "" {
+		return SortByCreation
+	}
+	return sort.Field
+}
+
+// fields returns document fields compared by sort, before _id, with their
+// values in key. Prices are ordered by currency first and then by amount,
+// as amounts in different currencies are not comparable.
+func (sort Sort) fields(key interface{}) bson.D {
+	switch sort.field() {
+	case SortByCreation:
+		return nil
+	case SortByPrice:
+		price, _ := key.(Money)
+		return bson.D{
+			{Key: "price.currency", Value: price.Currency},
+			{Key: "price.amount", Value: price.Amount},
+		}
+	}
+	return bson.D{{Key: sort.field(), Value: key}}
+}
+
+func (sort Sort) bson() bson.D {
+	direction := -1
+	if sort.Ascending {
+		direction = 1
+	}
+	var order bson.D
+	for _, field := range sort.fields(nil) {
+		order = append(order, bson.E{Key: field.Key, Value: direction})
+	}
+	return append(order, bson.E{Key: "_id", Value: direction})
+}
+
+// after returns filter matching documents which come after cursor, or nil
+// for the first page.
+func (sort Sort) after(cursor Cursor) bson.M {
+	if cursor.ID.IsZero() {
+		return nil
+	}
+	op := "$lt"
+	if sort.Ascending {
+		op = "$gt"
+	}
+	if sort.field() == SortByCreation {
+		return bson.M{"_id": bson.M{op: cursor.ID}}
+	}
+	fields := append(sort.fields(cursor.Key), bson.E{Key: "_id", Value: cursor.ID})
+	var or bson.A
+	for idx, field := range fields {
+		match := bson.M{field.Key: bson.M{op: field.Value}}
+		for _, equal := range fields[:idx] {
+			match[equal.Key] = equal.Value
+		}
+		or = append(or, match)
+	}
+	return bson.M{"$or": or}
+}
+
+// CompanyKey returns value of the sorted field of company.
+func (sort Sort) CompanyKey(company *Company) interface{} {
+	if sort.field() == SortByName {
+		return company.Name
+	}
+	return nil
+}
+
+// ServiceKey returns value of the sorted field of service.
+func (sort Sort) ServiceKey(service *Service) interface{} {
+	switch sort.field() {
+	case SortByName:
+		return service.Name
+	case SortByPrice:
+		return service.Price
+	case SortByDuration:
+		return service.Duration
+	}
+	return nil
+}
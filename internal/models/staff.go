@@ -0,0 +1,150 @@
+package models
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/msik-404/micro-appoint-companies/internal/database"
+)
+
+// Staff is a member of staff of the company, who can perform services
+// listing the member in StaffIDs.
+type Staff struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID primitive.ObjectID `bson:"company_id,omitempty"`
+	Name      string             `bson:"name"`
+	Role      string             `bson:"role"`
+	Bio       string             `bson:"bio"`
+	// Active is false for staff members who do not take appointments.
+	Active bool `bson:"active"`
+	// Version is incremented on every update of the staff member.
+	Version int64 `bson:"version"`
+}
+
+type StaffUpdate struct {
+	Name   *string `bson:"name,omitempty"`
+	Role   *string `bson:"role,omitempty"`
+	Bio    *string `bson:"bio,omitempty"`
+	Active *bool   `bson:"active,omitempty"`
+}
+
+// Apply sets fields of the update on the staff member.
+func (staffUpdate *StaffUpdate) Apply(staff *Staff) {
+	if staffUpdate.Name != nil {
+		staff.Name = *staffUpdate.Name
+	}
+	if staffUpdate.Role != nil {
+		staff.Role = *staffUpdate.Role
+	}
+	if staffUpdate.Bio != nil {
+		staff.Bio = *staffUpdate.Bio
+	}
+	if staffUpdate.Active != nil {
+		staff.Active = *staffUpdate.Active
+	}
+}
+
+// InsertOne adds staff member to the company. Callers should check that
+// company exists with CompanyExists.
+func (staff *Staff) InsertOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+) (*mongo.InsertOneResult, error) {
+	staff.ID = primitive.NewObjectID()
+	staff.CompanyID = companyID
+	staff.Version = 1
+
+	coll := db.Collection(database.StaffCollName)
+	return coll.InsertOne(ctx, staff)
+}
+
+// UpdateOne updates the staff member and increments its version, version
+// works like in ServiceUpdate.UpdateOne.
+func (staffUpdate *StaffUpdate) UpdateOne(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	version *int64,
+) (*mongo.UpdateResult, error) {
+	coll := db.Collection(database.StaffCollName)
+	filter := serviceFilter(companyID, staffID, version)
+	update := bson.M{
+		"$set": staffUpdate,
+		"$inc": bson.M{"version": 1},
+	}
+	return coll.UpdateOne(ctx, filter, update)
+}
+
+// DeleteOneStaff deletes the staff member and removes the member from
+// services of the company. When version is not nil, staff member is deleted only if
+// its version is equal.
+func DeleteOneStaff(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+	version *int64,
+) (*mongo.DeleteResult, error) {
+	coll := db.Collection(database.StaffCollName)
+	result, err := coll.DeleteOne(ctx, serviceFilter(companyID, staffID, version))
+	if err != nil || result.DeletedCount == 0 {
+		return result, err
+	}
+	servicesColl := db.Collection(database.ServicesCollName)
+	_, err = servicesColl.UpdateMany(
+		ctx,
+		bson.M{"company_id": companyID, "staff_ids": staffID},
+		bson.M{
+			"$pull": bson.M{"staff_ids": staffID},
+			"$inc":  bson.M{"version": 1},
+		},
+	)
+	return result, err
+}
+
+func FindOneStaff(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+) *mongo.SingleResult {
+	coll := db.Collection(database.StaffCollName)
+	return coll.FindOne(ctx, serviceFilter(companyID, staffID, nil))
+}
+
+// StaffExists reports whether staff member with staffID belongs to the
+// company.
+func StaffExists(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	staffID primitive.ObjectID,
+) (bool, error) {
+	coll := db.Collection(database.StaffCollName)
+	filter := serviceFilter(companyID, staffID, nil)
+	count, err := coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	return count != 0, err
+}
+
+func FindManyStaff(
+	ctx context.Context,
+	db *mongo.Database,
+	companyID primitive.ObjectID,
+	activeOnly bool,
+) (*mongo.Cursor, error) {
+	opts := options.Find()
+	opts.SetSort(bson.M{"_id": 1})
+
+	filter := bson.M{"company_id": companyID}
+	if activeOnly {
+		filter["active"] = true
+	}
+	coll := db.Collection(database.StaffCollName)
+	return coll.Find(ctx, filter, opts)
+}
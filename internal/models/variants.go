@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Variant is an option of the service, for example short hair, which
+// replaces price and duration of the service when booked.
+type Variant struct {
+	Name     string `bson:"name"`
+	Price    Money  `bson:"price"`
+	Duration int32  `bson:"duration"`
+}
+
+// AddOn is an optional extra booked together with the service, its price
+// and duration are added to the ones of the service or its variant.
+type AddOn struct {
+	Name     string `bson:"name"`
+	Price    Money  `bson:"price"`
+	Duration int32  `bson:"duration"`
+}
+
+// formatOption formats variant or add-on for the audit log.
+func formatOption(name string, price Money, duration int32) string {
+	return fmt.Sprintf("%s %s %dmin", name, price, duration)
+}
+
+func formatVariants(variants []Variant) string {
+	formatted := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		formatted = append(
+			formatted,
+			formatOption(variant.Name, variant.Price, variant.Duration),
+		)
+	}
+	return strings.Join(formatted, "; ")
+}
+
+func formatAddOns(addOns []AddOn) string {
+	formatted := make([]string, 0, len(addOns))
+	for _, addOn := range addOns {
+		formatted = append(
+			formatted,
+			formatOption(addOn.Name, addOn.Price, addOn.Duration),
+		)
+	}
+	return strings.Join(formatted, "; ")
+}
@@ -0,0 +1,94 @@
+// Package pagetoken encodes pagination cursors as opaque tokens signed with
+// HMAC-SHA256, so clients can not forge them or see storage internals.
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strings"
+)
+
+// ErrInvalid is returned for malformed tokens and tokens with bad signature.
+var ErrInvalid = errors.New("page token is invalid")
+
+// Token is the position of the next page. Key is the sorted field value of
+// the last item of the previous page and ID its id. Scope identifies the
+// filters and sort order of the list the token was issued for.
+type Token struct {
+	Key   string `json:"k,omitempty"`
+	ID    string `json:"i"`
+	Scope string `json:"s"`
+}
+
+// Signer encodes and verifies tokens. Every replica of the service has to
+// use the same secret.
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret}
+}
+
+// ErrNoSecret is returned by SecretFromEnv outside development when
+// PAGE_TOKEN_SECRET is not set.
+var ErrNoSecret = errors.New("PAGE_TOKEN_SECRET should be set")
+
+// SecretFromEnv reads the secret from PAGE_TOKEN_SECRET env variable. When
+// it is not set in development a random secret is generated, then tokens
+// stay valid only until restart and only on this replica.
+func SecretFromEnv(development bool) ([]byte, error) {
+	if secret := os.Getenv("PAGE_TOKEN_SECRET"); secret != "" {
+		return []byte(secret), nil
+	}
+	if !development {
+		return nil, ErrNoSecret
+	}
+	log.Println("PAGE_TOKEN_SECRET is not set, using a random secret")
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	return secret, err
+}
+
+func (s *Signer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (s *Signer) Encode(token Token) (string, error) {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(payload) + "." +
+		encoding.EncodeToString(s.sign(payload)), nil
+}
+
+func (s *Signer) Decode(raw string) (Token, error) {
+	var token Token
+	encodedPayload, encodedMAC, ok := strings.Cut(raw, ".")
+	if !ok {
+		return token, ErrInvalid
+	}
+	encoding := base64.RawURLEncoding
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return token, ErrInvalid
+	}
+	mac, err := encoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.sign(payload)) {
+		return token, ErrInvalid
+	}
+	if json.Unmarshal(payload, &token) != nil {
+		return token, ErrInvalid
+	}
+	return token, nil
+}
@@ -0,0 +1,62 @@
+package pagetoken
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	token := Token{Key: "Barber", ID: "6478f1e2a1b2c3d4e5f60718", Scope: "scope"}
+	raw, err := signer.Encode(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := signer.Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != token {
+		t.Fatalf("expected %v, got %v", token, decoded)
+	}
+}
+
+func TestDecodeRejectsForgedTokens(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	raw, err := signer.Encode(Token{ID: "6478f1e2a1b2c3d4e5f60718"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSigner([]byte("other")).Encode(Token{ID: "6478f1e2a1b2c3d4e5f60718"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range []string{
+		"",
+		"garbage",
+		raw[:len(raw)-1],
+		"x" + raw,
+		other,
+	} {
+		if _, err := signer.Decode(raw); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("token %q should be rejected, got %v", raw, err)
+		}
+	}
+}
+
+func TestSecretFromEnv(t *testing.T) {
+	t.Setenv("PAGE_TOKEN_SECRET", "")
+	if _, err := SecretFromEnv(false); !errors.Is(err, ErrNoSecret) {
+		t.Fatalf("expected ErrNoSecret, got %v", err)
+	}
+	secret, err := SecretFromEnv(true)
+	if err != nil || len(secret) == 0 {
+		t.Fatalf("expected random secret in development, got %v %v", secret, err)
+	}
+
+	t.Setenv("PAGE_TOKEN_SECRET", "shared")
+	secret, err = SecretFromEnv(false)
+	if err != nil || string(secret) != "shared" {
+		t.Fatalf("unexpected secret %q %v", secret, err)
+	}
+}
@@ -0,0 +1,58 @@
+// Package purge periodically hard deletes soft deleted companies once
+// their retention period is over.
+package purge
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+)
+
+// DefaultRetention is used when DELETED_RETENTION env variable is not set.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// RetentionFromEnv reads retention of soft deleted companies from
+// DELETED_RETENTION env variable, for example "720h".
+func RetentionFromEnv() (time.Duration, error) {
+	value := os.Getenv("DELETED_RETENTION")
+	if value == "" {
+		return DefaultRetention, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// Once purges companies deleted more than retention ago.
+func Once(
+	ctx context.Context,
+	companies models.CompanyRepository,
+	retention time.Duration,
+) (int64, error) {
+	return companies.PurgeDeleted(ctx, time.Now().Add(-retention))
+}
+
+// Run purges companies every interval until ctx is done.
+func Run(
+	ctx context.Context,
+	companies models.CompanyRepository,
+	retention time.Duration,
+	interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		purged, err := Once(ctx, companies, retention)
+		if err != nil {
+			log.Printf("purge of deleted companies failed: %v", err)
+		} else if purged != 0 {
+			log.Printf("purged %d deleted companies", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package purge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msik-404/micro-appoint-companies/internal/models"
+	"github.com/msik-404/micro-appoint-companies/internal/models/memory"
+)
+
+func TestOnce(t *testing.T) {
+	ctx := context.Background()
+	companies := memory.NewStore().Companies()
+	deletedID, err := companies.InsertOne(ctx, &models.Company{Name: "Deleted"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := companies.InsertOne(ctx, &models.Company{Name: "Alive"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := companies.DeleteOne(ctx, deletedID); err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := Once(ctx, companies, time.Hour)
+	if err != nil || purged != 0 {
+		t.Fatalf("company within retention was purged: %d, %v", purged, err)
+	}
+	purged, err = Once(ctx, companies, -time.Hour)
+	if err != nil || purged != 1 {
+		t.Fatalf("expected one purged company, got %d, %v", purged, err)
+	}
+	if err := companies.RestoreOne(ctx, deletedID); err != models.ErrNotFound {
+		t.Fatalf("purged company should not be restorable: %v", err)
+	}
+}